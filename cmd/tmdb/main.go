@@ -3,35 +3,216 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/therenotomorrow/tmdb/internal/app"
 	"github.com/therenotomorrow/tmdb/internal/config"
 	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/export"
+)
+
+const (
+	// exitUsage is the status flag exits with on invalid arguments.
+	exitUsage = 2
+	// episodeOperand is the position of the optional episode in `season <id> <n> [episode]`.
+	episodeOperand = 2
+
+	usage = `Usage: %s [flags] [command]
+
+Commands:
+  login                        approve access to your account
+  login v4                     approve access to your account and lists
+  logout                       forget the session
+  rate <id> <value>            rate a movie from 0.5 to 10
+  watchlist add|rm <id>        add or remove a movie
+  watchlist ls [page]          show the watchlist
+  fav add|rm <id>, fav ls      the same for favorites
+  list create <name> [desc]    create a private list, needs login v4
+  list add <list> <id> [note]  add a movie to the list
+  list show <list> [page]      show a page of the list
+  list export <list>           print the whole list as CSV
+  sync                         fetch again the catalog movies changed since the last sync
+  sync add|rm <id>, sync ls    select the movies kept in the catalog
+  export-scan <file>           print the id and name of each record of a daily ID export
+  search <query>               search movies, TV shows and people, -media narrows it down
+  poster <id> [lang...]        print the best poster URL of a movie for each language
+  show <id>                    show a TV show and its seasons
+  season <id> <n> [episode]    list the episodes of a season, or show one of them
+  watch                        poll the -type list every -interval and print the titles coming and going
+
+Flags:
+`
 )
 
 var TMDBToken string //nolint:gochecknoglobals // for opportunity to set via `ldflags`
 
-func args() (int, string) {
-	page := flag.Int("page", 1, "Page number")
-	kind := flag.String("type", "", "The type of list [playing,popular,top,upcoming]")
+type options struct {
+	command   string
+	operands  []string
+	pages     string
+	kind      string
+	media     string
+	translate string
+	language  string
+	region    string
+	logFormat string
+	logLevel  string
+	interval  time.Duration
+	movieID   int
+	page      int
+	minPop    float64
+	adult     bool
+	video     bool
+}
+
+func args() options {
+	var opts options
+
+	flag.IntVar(&opts.movieID, "id", 0, "Movie id to show details for")
+	flag.IntVar(&opts.page, "page", 1, "Page number")
+	flag.StringVar(&opts.pages, "pages", "", "Range of pages to fetch at once, e.g. 1-10")
+	flag.StringVar(&opts.translate, "translations", "", "Languages to show the -id title in, e.g. de,es-MX")
+	flag.StringVar(&opts.kind, "type", "", "The type of list [playing,popular,top,upcoming], watch also takes latest")
+	flag.StringVar(&opts.media, "media", "", "What search looks for [multi,movie,tv,person] (default multi)")
+	flag.StringVar(&opts.language, "lang", "", "Language of the results, e.g. de or pt-BR (default en)")
+	flag.StringVar(&opts.region, "region", "", "Region of the release dates and listings, e.g. US")
+	flag.DurationVar(&opts.interval, "interval", time.Hour, "Time between the polls of watch, e.g. 30m")
+	flag.StringVar(&opts.logFormat, "log-format", "", "Request log format [text,json]")
+	flag.StringVar(&opts.logLevel, "log-level", "", "Request log level [debug,info,warn,error]")
+	flag.Float64Var(&opts.minPop, "min-popularity", 0, "Skip the export records less popular than this")
+	flag.BoolVar(&opts.adult, "adult", false, "Keep the adult export records")
+	flag.BoolVar(&opts.video, "video", false, "Keep the video export records")
+
+	flag.Usage = func() {
+		fp.Silent(fmt.Fprintf(flag.CommandLine.Output(), usage, os.Args[0]))
+		flag.PrintDefaults()
+	}
 
 	flag.Parse()
 
-	return *page, *kind
+	opts.command = flag.Arg(0)
+	opts.operands = flag.Args()[min(1, flag.NArg()):]
+
+	commands := []string{
+		"", "login", "logout", "rate", "watchlist", "fav", "list", "sync", "export-scan", "watch",
+		"show", "season", "search", "poster",
+	}
+	if !slices.Contains(commands, opts.command) {
+		flag.Usage()
+		os.Exit(exitUsage)
+	}
+
+	return opts
+}
+
+// operand returns the command operand at idx, empty when it is missing.
+func (o options) operand(idx int) string {
+	if idx < len(o.operands) {
+		return o.operands[idx]
+	}
+
+	return ""
+}
+
+// languages splits the -translations list.
+func (o options) languages() []string {
+	return strings.FieldsFunc(o.translate, func(char rune) bool { return char == ',' || char == ' ' })
 }
 
 func main() {
-	ctx := context.Background()
-	page, kind := args()
+	opts := args()
+
+	// watch runs until interrupted, the other commands stop at the request in flight
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	settings := fp.Must(config.New())
-	if settings.Token == "" {
+	if settings.Config.Token == "" {
 		settings.SetToken(TMDBToken)
 	}
 
+	settings.SetLocale(opts.language, opts.region)
+
+	if opts.logFormat != "" {
+		settings.LogFormat = opts.logFormat
+	}
+
+	if opts.logLevel != "" {
+		settings.LogLevel = opts.logLevel
+	}
+
+	settings.Config.Logger = fp.Must(settings.NewLogger(os.Stderr))
+
 	tmdb := fp.Must(app.New(settings))
 
 	defer func() { _ = tmdb.Close() }()
 
-	tmdb.Fetch(ctx, page, kind)
+	// export-scan reads a local file, the other commands fail early on a language or region TMDB does not know
+	if opts.command != "export-scan" && !tmdb.CheckLocale(ctx) {
+		return
+	}
+
+	if opts.command != "" {
+		command(ctx, tmdb, opts)
+
+		return
+	}
+
+	if opts.movieID != 0 {
+		tmdb.Details(ctx, opts.movieID, opts.languages()...)
+
+		return
+	}
+
+	if opts.pages != "" {
+		tmdb.FetchPages(ctx, opts.pages, opts.kind)
+
+		return
+	}
+
+	tmdb.Fetch(ctx, opts.page, opts.kind)
+}
+
+// command runs one of the commands args accepts.
+func command(ctx context.Context, tmdb *app.TMDB, opts options) {
+	switch opts.command {
+	case "login":
+		if opts.operand(0) == "v4" {
+			tmdb.LoginV4(ctx)
+		} else {
+			tmdb.Login(ctx)
+		}
+	case "logout":
+		tmdb.Logout(ctx)
+	case "rate":
+		tmdb.Rate(ctx, opts.operand(0), opts.operand(1))
+	case "watchlist":
+		tmdb.Watchlist(ctx, opts.operand(0), opts.operand(1))
+	case "fav":
+		tmdb.Favorites(ctx, opts.operand(0), opts.operand(1))
+	case "list":
+		tmdb.List(ctx, opts.operand(0), opts.operands[min(1, len(opts.operands)):]...)
+	case "sync":
+		tmdb.Sync(ctx, opts.operand(0), opts.operand(1))
+	case "export-scan":
+		filter := export.Filter{MinPopularity: opts.minPop, SkipAdult: !opts.adult, SkipVideo: !opts.video}
+
+		tmdb.ExportScan(ctx, opts.operand(0), filter)
+	case "search":
+		tmdb.Search(ctx, opts.media, strings.Join(opts.operands, " "), opts.page)
+	case "poster":
+		tmdb.Posters(ctx, opts.operand(0), opts.operands[min(1, len(opts.operands)):]...)
+	case "show":
+		tmdb.Show(ctx, opts.operand(0))
+	case "season":
+		tmdb.Season(ctx, opts.operand(0), opts.operand(1), opts.operand(episodeOperand))
+	case "watch":
+		tmdb.Watch(ctx, opts.kind, opts.pages, opts.interval)
+	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"time"
 
 	"github.com/samber/oops"
 
@@ -24,6 +25,7 @@ const (
 	fetchTypePopular  fetchType = "popular"
 	fetchTypeTop      fetchType = "top"
 	fetchTypeUpcoming fetchType = "upcoming"
+	fetchTypeLatest   fetchType = "latest"
 
 	errUnexpected = "unexpectedError"
 	errNotFound   = "notFound"
@@ -35,6 +37,8 @@ type (
 		client   tmdb.Client
 		input    io.Reader
 		output   io.Writer
+		clock    func() time.Time
+		after    func(time.Duration) <-chan time.Time
 		settings config.Settings
 	}
 
@@ -51,7 +55,15 @@ func New(settings config.Settings) (*TMDB, error) {
 		return nil, errBuilder.Wrap(err)
 	}
 
-	return &TMDB{settings: settings, client: client, input: os.Stdin, output: os.Stdout, oops: errBuilder}, nil
+	return &TMDB{
+		settings: settings,
+		client:   client,
+		input:    os.Stdin,
+		output:   os.Stdout,
+		clock:    time.Now,
+		after:    time.After,
+		oops:     errBuilder,
+	}, nil
 }
 
 func (a *TMDB) WithDependencies(deps ...any) *TMDB {
@@ -63,12 +75,25 @@ func (a *TMDB) WithDependencies(deps ...any) *TMDB {
 			a.input = dep
 		case io.Writer:
 			a.output = dep
+		case func() time.Time:
+			a.clock = dep
+		case func(time.Duration) <-chan time.Time:
+			a.after = dep
 		}
 	}
 
 	return a
 }
 
+// CheckLocale reports a Language or Region TMDB does not know, before any command uses them. It is false then.
+func (a *TMDB) CheckLocale(ctx context.Context) bool {
+	err := a.client.CheckLocale(ctx)
+
+	a.report(err)
+
+	return err == nil
+}
+
 func (a *TMDB) Close() error {
 	return a.oops.Code(errUnexpected).Public("Cannot close application.").Wrap(a.client.Close())
 }
@@ -3,12 +3,15 @@ package app_test
 import (
 	"bufio"
 	"io"
+	"log/slog"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/samber/oops"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/therenotomorrow/tmdb/internal/app"
@@ -24,13 +27,32 @@ func New(debug ...bool) *app.TMDB {
 	}
 
 	return fp.Must(app.New(config.Settings{
-		Debug: debug[0],
-		Token: "secret",
+		Debug:       debug[0],
+		Token:       "secret",
+		APIKey:      "",
+		LogFormat:   "",
+		LogLevel:    "",
+		SessionID:   "",
+		SessionFile: "",
+		AccessToken: "",
+		CatalogFile: "",
+		WatchDir:    "",
+		Language:    "",
+		Region:      "",
 		Config: tmdb.Config{
-			Host:    "https://tmdb.host",
-			Token:   "secret",
-			Timeout: time.Minute,
-			Debug:   debug[0],
+			Host:           "https://tmdb.host",
+			Token:          "secret",
+			Auth:           "",
+			SessionID:      "",
+			GuestSessionID: "",
+			AccessToken:    "",
+			Language:       "",
+			Region:         "",
+			Timeout:        time.Minute,
+			Logger:         nil,
+			Tracing:        nil,
+			Metrics:        nil,
+			Breaker:        nil,
 		},
 	}))
 }
@@ -51,12 +73,31 @@ func TestNew(t *testing.T) {
 			name: "min settings",
 			args: args{
 				settings: config.Settings{
-					Token: "secret",
+					Token:       "secret",
+					APIKey:      "",
+					LogFormat:   "",
+					LogLevel:    "",
+					SessionID:   "",
+					SessionFile: "",
+					AccessToken: "",
+					CatalogFile: "",
+					WatchDir:    "",
+					Language:    "",
+					Region:      "",
 					Config: tmdb.Config{
-						Host:    "https://tmdb.host",
-						Token:   "secret",
-						Timeout: time.Minute,
-						Debug:   false,
+						Host:           "https://tmdb.host",
+						Token:          "secret",
+						Auth:           "",
+						SessionID:      "",
+						GuestSessionID: "",
+						AccessToken:    "",
+						Language:       "",
+						Region:         "",
+						Timeout:        time.Minute,
+						Logger:         nil,
+						Tracing:        nil,
+						Metrics:        nil,
+						Breaker:        nil,
 					},
 					Debug: false,
 				},
@@ -67,12 +108,31 @@ func TestNew(t *testing.T) {
 			name: "max settings",
 			args: args{
 				settings: config.Settings{
-					Token: "secret",
+					Token:       "secret",
+					APIKey:      "",
+					LogFormat:   "",
+					LogLevel:    "",
+					SessionID:   "",
+					SessionFile: "",
+					AccessToken: "",
+					CatalogFile: "",
+					WatchDir:    "",
+					Language:    "",
+					Region:      "",
 					Config: tmdb.Config{
-						Host:    "https://tmdb.host",
-						Token:   "secret",
-						Timeout: time.Minute,
-						Debug:   true,
+						Host:           "https://tmdb.host",
+						Token:          "secret",
+						Auth:           "",
+						SessionID:      "",
+						GuestSessionID: "",
+						AccessToken:    "",
+						Language:       "",
+						Region:         "",
+						Timeout:        time.Minute,
+						Logger:         slog.New(slog.DiscardHandler),
+						Tracing:        nil,
+						Metrics:        nil,
+						Breaker:        nil,
 					},
 					Debug: true,
 				},
@@ -83,12 +143,31 @@ func TestNew(t *testing.T) {
 			name: "settings error",
 			args: args{
 				settings: config.Settings{
-					Token: "",
+					Token:       "",
+					APIKey:      "",
+					LogFormat:   "",
+					LogLevel:    "",
+					SessionID:   "",
+					SessionFile: "",
+					AccessToken: "",
+					CatalogFile: "",
+					WatchDir:    "",
+					Language:    "",
+					Region:      "",
 					Config: tmdb.Config{
-						Host:    "https://tmdb.host",
-						Token:   "",
-						Timeout: time.Minute,
-						Debug:   true,
+						Host:           "https://tmdb.host",
+						Token:          "",
+						Auth:           "",
+						SessionID:      "",
+						GuestSessionID: "",
+						AccessToken:    "",
+						Language:       "",
+						Region:         "",
+						Timeout:        time.Minute,
+						Logger:         slog.New(slog.DiscardHandler),
+						Tracing:        nil,
+						Metrics:        nil,
+						Breaker:        nil,
 					},
 					Debug: true,
 				},
@@ -143,6 +222,23 @@ func TestTMDBWithDependencies(t *testing.T) {
 	assert.Same(t, obj1, obj3)
 }
 
+func TestTMDBCheckLocale(t *testing.T) {
+	t.Parallel()
+
+	invalid := oops.Public(`Invalid language "zz": TMDB does not know it.`).New("unknown language")
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("CheckLocale", mock.Anything).Once().Return(nil)
+	client.On("CheckLocale", mock.Anything).Once().Return(invalid)
+
+	obj := New().WithDependencies(output, client)
+
+	assert.True(t, obj.CheckLocale(t.Context()))
+	assert.Empty(t, output.String())
+	assert.False(t, obj.CheckLocale(t.Context()))
+	assert.Equal(t, "Invalid language \"zz\": TMDB does not know it.\n", output.String())
+}
+
 func TestTMDBClose(t *testing.T) {
 	t.Parallel()
 
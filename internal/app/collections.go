@@ -0,0 +1,130 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	actionAdd    = "add"
+	actionRemove = "rm"
+	actionList   = "ls"
+)
+
+// collection is an account movie collection the CLI manages: the watchlist or the favorites.
+type collection struct {
+	set  func(ctx context.Context, media tmdb.MediaType, mediaID int, value bool) error
+	list func(ctx context.Context, page int) (tmdb.MoviesPage, error)
+	name string
+}
+
+// Watchlist adds a movie to the watchlist, removes it or lists a page of the watchlist: add|rm <id>, ls [page].
+func (a *TMDB) Watchlist(ctx context.Context, action, operand string) {
+	a.collection(ctx, collection{set: a.client.SetWatchlist, list: a.client.GetWatchlistMovies, name: "watchlist"},
+		action, operand)
+}
+
+// Favorites manages the favorite movies the same way Watchlist does.
+func (a *TMDB) Favorites(ctx context.Context, action, operand string) {
+	a.collection(ctx, collection{set: a.client.SetFavorite, list: a.client.GetFavoriteMovies, name: "favorites"},
+		action, operand)
+}
+
+func (a *TMDB) collection(ctx context.Context, coll collection, action, operand string) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	if action == actionList {
+		err = a.listCollection(ctx, coll, operand)
+
+		return
+	}
+
+	if action != actionAdd && action != actionRemove {
+		err = a.oops.Code(errNotFound).
+			With("action", action).
+			Public(fmt.Sprintf("Unknown %s action. Allowed [add,rm,ls]", coll.name)).
+			New("invalid action")
+
+		return
+	}
+
+	movieID, err := a.parseID("Movie", operand)
+	if err != nil {
+		return
+	}
+
+	err = oops.Wrap(coll.set(ctx, tmdb.MediaMovie, movieID, action == actionAdd))
+	if err != nil {
+		return
+	}
+
+	if action == actionAdd {
+		fp.Silent(fmt.Fprintf(a.output, "Added %d to the %s.\n", movieID, coll.name))
+	} else {
+		fp.Silent(fmt.Fprintf(a.output, "Removed %d from the %s.\n", movieID, coll.name))
+	}
+}
+
+func (a *TMDB) listCollection(ctx context.Context, coll collection, operand string) error {
+	page, err := a.parsePage(operand)
+	if err != nil {
+		return err
+	}
+
+	movies, err := oops.Wrap2(coll.list(ctx, page))
+	if err != nil {
+		return err
+	}
+
+	if movies.TotalResults == 0 {
+		fp.Silent(fmt.Fprintf(a.output, "Nothing in the %s yet.\n", coll.name))
+
+		return nil
+	}
+
+	for _, movie := range movies.Results {
+		a.print(movie)
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Page %d of %d.\n", movies.Page, movies.TotalPages))
+
+	return nil
+}
+
+// parsePage reads an optional page operand, the first page when it is empty.
+func (a *TMDB) parsePage(operand string) (int, error) {
+	if operand == "" {
+		return 1, nil
+	}
+
+	page, err := strconv.Atoi(operand)
+	if err != nil || page < 1 {
+		return 0, a.oops.Code(errUnexpected).
+			With("page", operand).
+			Public("Invalid page: Pages start at 1 and max at 500. They are expected to be an integer.").
+			New("invalid page")
+	}
+
+	return page, nil
+}
+
+// parseID reads a positive id. what names the kind of id, capitalised, for the error message.
+func (a *TMDB) parseID(what, operand string) (int, error) {
+	parsed, err := strconv.Atoi(operand)
+	if err != nil || parsed < 1 {
+		return 0, a.oops.Code(errUnexpected).
+			With("id", operand).
+			Public(fmt.Sprintf("Invalid id: %s ids are positive integers.", what)).
+			New("invalid id")
+	}
+
+	return parsed, nil
+}
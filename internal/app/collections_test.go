@@ -0,0 +1,98 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func TestTMDBWatchlist(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("SetWatchlist", mock.Anything, tmdb.MediaMovie, 550, true).Once().Return(nil)
+	client.On("SetWatchlist", mock.Anything, tmdb.MediaMovie, 550, false).Once().Return(nil)
+	client.On("GetWatchlistMovies", mock.Anything, 2).Once().
+		Return(tmdb.MoviesPage{Results: movies(), Page: 2, TotalPages: 3, TotalResults: 42}, nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Watchlist(t.Context(), "add", "550")
+	obj.Watchlist(t.Context(), "rm", "550")
+	obj.Watchlist(t.Context(), "ls", "2")
+
+	got := output.String()
+
+	assert.True(t, strings.HasPrefix(got, "Added 550 to the watchlist.\nRemoved 550 from the watchlist.\n"))
+	assert.Contains(t, got, `---- "title1" ----`)
+	assert.Contains(t, got, `---- "title2" ----`)
+	assert.True(t, strings.HasSuffix(got, "Page 2 of 3.\n"))
+}
+
+func TestTMDBFavorites(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("SetFavorite", mock.Anything, tmdb.MediaMovie, 13, true).Once().Return(errFail)
+	client.On("GetFavoriteMovies", mock.Anything, 1).Once().
+		Return(tmdb.MoviesPage{Results: []tmdb.Movie{}, Page: 1, TotalPages: 0, TotalResults: 0}, nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Favorites(t.Context(), "add", "13")
+	obj.Favorites(t.Context(), "ls", "")
+
+	assert.Equal(t, "Something went wrong.\nNothing in the favorites yet.\n", output.String())
+}
+
+func TestTMDBCollectionFailure(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		action  string
+		operand string
+	}
+
+	tests := []struct {
+		name string
+		want string
+		args args
+	}{
+		{
+			name: "unknown action",
+			args: args{action: "clear", operand: ""},
+			want: "Unknown watchlist action. Allowed [add,rm,ls]\n",
+		},
+		{
+			name: "invalid id",
+			args: args{action: "add", operand: "fight"},
+			want: "Invalid id: Movie ids are positive integers.\n",
+		},
+		{
+			name: "invalid page",
+			args: args{action: "ls", operand: "0"},
+			want: "Invalid page: Pages start at 1 and max at 500. They are expected to be an integer.\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := new(strings.Builder)
+
+			obj := New().WithDependencies(output, mocks.NewMockClient(t))
+
+			obj.Watchlist(t.Context(), test.args.action, test.args.operand)
+
+			assert.Equal(t, test.want, output.String())
+		})
+	}
+}
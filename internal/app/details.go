@@ -0,0 +1,183 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	detailsTemplate = `---- %q (%s) ----
+ * Tagline: %s
+ * Runtime: %d min
+ * Genres: %s
+ * Votes: %d (%.1f)
+ * Director: %s
+ * Cast: %s
+ * Keywords: %s
+ * Trailer: %s
+ * IMDb: %s
+ * Recommended: %s
+ > %s
+`
+
+	detailsLimit = 5
+)
+
+// Details shows a movie. Each of languages, like `de` or `es-MX`, adds its title and overview side by side below.
+func (a *TMDB) Details(ctx context.Context, movieID int, languages ...string) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	if movieID < 1 {
+		err = a.oops.Code(errUnexpected).
+			Public("Invalid id: Movie ids are positive integers.").
+			New("id less then one")
+
+		return
+	}
+
+	appends := []tmdb.Append{
+		tmdb.AppendCredits,
+		tmdb.AppendVideos,
+		tmdb.AppendKeywords,
+		tmdb.AppendExternalIDs,
+		tmdb.AppendRecommendations,
+	}
+
+	if len(languages) > 0 {
+		appends = append(appends, tmdb.AppendAltTitles, tmdb.AppendTranslations)
+	}
+
+	details, err := oops.Wrap2(a.client.GetMovieDetails(ctx, movieID, tmdb.DetailsOptions{Append: appends}))
+	if err != nil {
+		return
+	}
+
+	fp.Silent(fmt.Fprintf(
+		a.output,
+		detailsTemplate,
+		details.Title,
+		details.ReleaseDate,
+		details.Tagline,
+		details.Runtime,
+		genres(details.Genres),
+		details.VoteCount,
+		details.VoteAverage,
+		director(details.Credits),
+		cast(details.Credits),
+		keywords(details.Keywords),
+		trailer(details.Videos),
+		imdb(details.ExternalIDs),
+		recommended(details.Recommendations),
+		details.Overview,
+	))
+
+	if len(languages) > 0 {
+		a.translations(details, languages)
+	}
+}
+
+func genres(items []tmdb.Genre) string {
+	names := make([]string, 0, len(items))
+
+	for _, genre := range items {
+		names = append(names, genre.Name)
+	}
+
+	return join(names)
+}
+
+func director(credits *tmdb.Credits) string {
+	if credits == nil {
+		return join(nil)
+	}
+
+	names := make([]string, 0)
+
+	for _, crew := range credits.Crew {
+		if crew.Job == "Director" {
+			names = append(names, crew.Name)
+		}
+	}
+
+	return join(names)
+}
+
+func cast(credits *tmdb.Credits) string {
+	if credits == nil {
+		return join(nil)
+	}
+
+	names := make([]string, 0, len(credits.Cast))
+
+	for _, person := range credits.Cast {
+		names = append(names, person.Name)
+	}
+
+	return join(names)
+}
+
+func keywords(items *tmdb.Keywords) string {
+	if items == nil {
+		return join(nil)
+	}
+
+	names := make([]string, 0, len(items.Keywords))
+
+	for _, keyword := range items.Keywords {
+		names = append(names, keyword.Name)
+	}
+
+	return join(names)
+}
+
+func trailer(videos *tmdb.Videos) string {
+	if videos == nil {
+		return join(nil)
+	}
+
+	for _, video := range videos.Results {
+		if video.Site == "YouTube" && video.Type == "Trailer" {
+			return "https://www.youtube.com/watch?v=" + video.Key
+		}
+	}
+
+	return join(nil)
+}
+
+func imdb(ids *tmdb.ExternalIDs) string {
+	if ids == nil || ids.IMDbID == "" {
+		return join(nil)
+	}
+
+	return "https://www.imdb.com/title/" + ids.IMDbID
+}
+
+func recommended(page *tmdb.MoviesPage) string {
+	if page == nil {
+		return join(nil)
+	}
+
+	names := make([]string, 0, len(page.Results))
+
+	for _, movie := range page.Results {
+		names = append(names, movie.Title)
+	}
+
+	return join(names)
+}
+
+func join(names []string) string {
+	if len(names) == 0 {
+		return "-"
+	}
+
+	return strings.Join(names[:min(len(names), detailsLimit)], ", ")
+}
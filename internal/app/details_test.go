@@ -0,0 +1,147 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func details() tmdb.MovieDetails {
+	details := new(tmdb.MovieDetails)
+
+	details.Title = "Fight Club"
+	details.Overview = "overview"
+	details.ReleaseDate = "1999-10-15"
+	details.ID = 550
+	details.VoteCount = 26280
+	details.VoteAverage = 8.438
+	details.Runtime = 139
+	details.Tagline = "Mischief. Mayhem. Soap."
+	details.Genres = []tmdb.Genre{{Name: "Drama", ID: 18}, {Name: "Thriller", ID: 53}}
+	details.Credits = &tmdb.Credits{
+		Cast: []tmdb.Cast{
+			cast("Edward Norton"), cast("Brad Pitt"), cast("Helena Bonham Carter"),
+			cast("Meat Loaf"), cast("Jared Leto"), cast("Zach Grenier"),
+		},
+		Crew: []tmdb.Crew{
+			{Name: "Jim Uhls", Job: "Screenplay", Department: "Writing", ID: 7468},
+			{Name: "David Fincher", Job: "Director", Department: "Directing", ID: 7467},
+		},
+	}
+	details.Videos = &tmdb.Videos{Results: []tmdb.Video{
+		{Key: "teaser", Name: "Teaser", Site: "YouTube", Type: "Teaser", Language: "en", Official: true},
+		{Key: "BdJKm16Co6M", Name: "Trailer", Site: "YouTube", Type: "Trailer", Language: "en", Official: true},
+	}}
+	details.Keywords = &tmdb.Keywords{Keywords: []tmdb.Keyword{
+		{Name: "dual identity", ID: 825},
+		{Name: "nihilism", ID: 851},
+	}}
+	details.ExternalIDs = new(tmdb.ExternalIDs)
+	details.ExternalIDs.IMDbID = "tt0137523"
+	details.Recommendations = new(tmdb.MoviesPage)
+	details.Recommendations.Results = []tmdb.Movie{{
+		Title:       "Se7en",
+		Overview:    "",
+		ReleaseDate: "1995-09-22",
+		Popularity:  0,
+		ID:          807,
+		VoteCount:   0,
+	}}
+
+	return *details
+}
+
+func cast(name string) tmdb.Cast {
+	return tmdb.Cast{Name: name, Character: "", ID: 0, Order: 0}
+}
+
+func TestTMDBDetailsSuccess(t *testing.T) {
+	t.Parallel()
+
+	want := `---- "Fight Club" (1999-10-15) ----
+ * Tagline: Mischief. Mayhem. Soap.
+ * Runtime: 139 min
+ * Genres: Drama, Thriller
+ * Votes: 26280 (8.4)
+ * Director: David Fincher
+ * Cast: Edward Norton, Brad Pitt, Helena Bonham Carter, Meat Loaf, Jared Leto
+ * Keywords: dual identity, nihilism
+ * Trailer: https://www.youtube.com/watch?v=BdJKm16Co6M
+ * IMDb: https://www.imdb.com/title/tt0137523
+ * Recommended: Se7en
+ > overview
+`
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetMovieDetails", mock.Anything, 550, mock.MatchedBy(func(opts tmdb.DetailsOptions) bool {
+		return len(opts.Append) == 5
+	})).Return(details(), nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Details(t.Context(), 550)
+
+	assert.Equal(t, want, output.String())
+}
+
+func TestTMDBDetailsEmpty(t *testing.T) {
+	t.Parallel()
+
+	want := `---- "Fight Club" () ----
+ * Tagline: 
+ * Runtime: 0 min
+ * Genres: -
+ * Votes: 0 (0.0)
+ * Director: -
+ * Cast: -
+ * Keywords: -
+ * Trailer: -
+ * IMDb: -
+ * Recommended: -
+ > 
+`
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	empty := new(tmdb.MovieDetails)
+	empty.Title = "Fight Club"
+
+	client.On("GetMovieDetails", mock.Anything, 550, mock.Anything).Return(*empty, nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Details(t.Context(), 550)
+
+	assert.Equal(t, want, output.String())
+}
+
+func TestTMDBDetailsInvalidID(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	obj := New().WithDependencies(output)
+
+	obj.Details(t.Context(), -1)
+
+	assert.Equal(t, "Invalid id: Movie ids are positive integers.\n", output.String())
+}
+
+func TestTMDBDetailsFailure(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetMovieDetails", mock.Anything, 550, mock.Anything).Return(*new(tmdb.MovieDetails), errFail)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Details(t.Context(), 550)
+
+	assert.Equal(t, "Something went wrong.\n", output.String())
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/export"
+)
+
+// ExportScan prints the id and name of every record of the export file at path the filter keeps, tab separated, to
+// seed bulk jobs without calling the API. It stops at the first invalid line and prints nothing but the error then, a
+// partial list being no seed.
+func (a *TMDB) ExportScan(ctx context.Context, path string, filter export.Filter) {
+	var (
+		err    error
+		output bytes.Buffer
+	)
+
+	defer func() { a.report(err) }()
+
+	for rec, errRead := range export.ReadFile(path, filter) {
+		if errRead != nil {
+			err = oops.Wrap(errRead)
+
+			return
+		}
+
+		if err = oops.Wrap(ctx.Err()); err != nil {
+			return
+		}
+
+		fp.Silent(fmt.Fprintf(&output, "%d\t%s\n", rec.ID, rec.Name))
+	}
+
+	fp.Silent(output.WriteTo(a.output))
+}
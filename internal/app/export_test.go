@@ -0,0 +1,95 @@
+package app_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/export"
+)
+
+func exportFile(t *testing.T, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "movie_ids_10_19_2026.json")
+
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	return path
+}
+
+func TestTMDBExportScan(t *testing.T) {
+	t.Parallel()
+
+	path := exportFile(t, `{"adult":false,"id":550,"original_title":"Fight Club","popularity":61.4,"video":false}
+{"adult":true,"id":1,"original_title":"Adult","popularity":3.2,"video":false}
+{"adult":false,"id":13,"original_title":"Forrest Gump","popularity":48.3,"video":false}
+`)
+
+	output := new(strings.Builder)
+	filter := export.Filter{MinPopularity: 1, SkipAdult: true, SkipVideo: true}
+
+	New().WithDependencies(output, mocks.NewMockClient(t)).ExportScan(t.Context(), path, filter)
+
+	assert.Equal(t, "550\tFight Club\n13\tForrest Gump\n", output.String())
+}
+
+func TestTMDBExportScanFailure(t *testing.T) {
+	t.Parallel()
+
+	invalid := exportFile(t, "{\"id\":550,\"original_title\":\"Fight Club\"}\n{\n{\"id\":13}\n")
+	// more records before the invalid line than an output buffer holds
+	late := exportFile(t, strings.Repeat("{\"id\":550,\"original_title\":\"Fight Club\"}\n", 1000)+"{\n")
+	canceled, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	tests := []struct {
+		ctx  context.Context
+		name string
+		path string
+		want string
+	}{
+		{
+			ctx:  t.Context(),
+			name: "missing file",
+			path: filepath.Join(t.TempDir(), "missing.json.gz"),
+			want: "Cannot read the export file.\n",
+		},
+		{
+			ctx:  t.Context(),
+			name: "invalid line",
+			path: invalid,
+			want: "Invalid export record: Each line is expected to be a JSON object.\n",
+		},
+		{
+			ctx:  t.Context(),
+			name: "invalid late line",
+			path: late,
+			want: "Invalid export record: Each line is expected to be a JSON object.\n",
+		},
+		{
+			ctx:  canceled,
+			name: "canceled",
+			path: invalid,
+			want: "Something went wrong.\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := new(strings.Builder)
+
+			New().WithDependencies(output, mocks.NewMockClient(t)).ExportScan(test.ctx, test.path, *new(export.Filter))
+
+			assert.Equal(t, test.want, output.String())
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/samber/oops"
 
 	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
 )
 
 func (a *TMDB) Select(kind string) FetchFunc {
@@ -53,23 +54,11 @@ func (a *TMDB) Fetch(ctx context.Context, page int, kind string) {
 func (a *TMDB) fetch(ctx context.Context, page int, fetcher FetchFunc) error {
 	var err error
 
-	defer func() {
-		if err == nil {
-			return
-		}
-
-		if a.settings.Debug {
-			fp.Silent(fmt.Fprintf(a.output, "%+v\n", err))
-		} else {
-			fp.Silent(fmt.Fprintf(a.output, "%s\n", oops.GetPublic(err, "Something went wrong.")))
-		}
-	}()
+	defer func() { a.report(err) }()
 
 	switch {
 	case fetcher == nil:
-		err = a.oops.Code(errNotFound).
-			Public(`Unknown "-type" value for fetch. Allowed [playing,popular,top,upcoming]`).
-			New("invalid type")
+		err = a.unknownType()
 	case page < 1:
 		err = a.oops.Code(errUnexpected).
 			Public("Invalid page: Pages start at 1 and max at 500. They are expected to be an integer.").
@@ -88,15 +77,7 @@ func (a *TMDB) fetch(ctx context.Context, page int, fetcher FetchFunc) error {
 	fp.Silent(fmt.Fprintln(a.output))
 
 	for _, movie := range movies {
-		fp.Silent(fmt.Fprintf(
-			a.output,
-			template,
-			movie.Title,
-			movie.ReleaseDate,
-			movie.VoteCount,
-			movie.Popularity,
-			movie.Overview,
-		))
+		a.print(movie)
 		fp.Silent(a.input.Read(make([]byte, 1)))
 	}
 
@@ -104,3 +85,33 @@ func (a *TMDB) fetch(ctx context.Context, page int, fetcher FetchFunc) error {
 
 	return nil
 }
+
+func (a *TMDB) unknownType() error {
+	return a.oops.Code(errNotFound).
+		Public(`Unknown "-type" value for fetch. Allowed [playing,popular,top,upcoming]`).
+		New("invalid type")
+}
+
+func (a *TMDB) print(movie tmdb.Movie) {
+	fp.Silent(fmt.Fprintf(
+		a.output,
+		template,
+		movie.Title,
+		movie.ReleaseDate,
+		movie.VoteCount,
+		movie.Popularity,
+		movie.Overview,
+	))
+}
+
+func (a *TMDB) report(err error) {
+	if err == nil {
+		return
+	}
+
+	if a.settings.Debug {
+		fp.Silent(fmt.Fprintf(a.output, "%+v\n", err))
+	} else {
+		fp.Silent(fmt.Fprintf(a.output, "%s\n", oops.GetPublic(err, "Something went wrong.")))
+	}
+}
@@ -20,12 +20,14 @@ func movies() []tmdb.Movie {
 		Title:       "title1",
 		Overview:    "overview1",
 		ReleaseDate: "releaseDate1",
+		ID:          1,
 		Popularity:  12.34,
 		VoteCount:   100,
 	}, {
 		Title:       "title2",
 		Overview:    "overview2",
 		ReleaseDate: "releaseDate2",
+		ID:          2,
 		Popularity:  56.78,
 		VoteCount:   200,
 	}}
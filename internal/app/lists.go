@@ -0,0 +1,168 @@
+package app
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	actionCreate = "create"
+	actionShow   = "show"
+	actionExport = "export"
+
+	listOperands = 3
+)
+
+// List manages the v4 lists of the logged in user: create <name> [description], add <list> <movie> [comment],
+// show <list> [page] and export <list>, which writes every item as CSV.
+func (a *TMDB) List(ctx context.Context, action string, operands ...string) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	// missing operands read as empty
+	operands = append(operands, make([]string, listOperands)...)
+
+	switch action {
+	case actionCreate:
+		err = a.createList(ctx, operands[0], operands[1])
+	case actionAdd:
+		err = a.addToList(ctx, operands[0], operands[1], operands[2])
+	case actionShow:
+		err = a.showList(ctx, operands[0], operands[1])
+	case actionExport:
+		err = a.exportList(ctx, operands[0])
+	default:
+		err = a.oops.Code(errNotFound).
+			With("action", action).
+			Public("Unknown list action. Allowed [create,add,show,export]").
+			New("invalid action")
+	}
+}
+
+func (a *TMDB) createList(ctx context.Context, name, description string) error {
+	opts := tmdb.ListOptions{Name: name, Description: description, Language: "", Public: false}
+
+	listID, err := oops.Wrap2(a.client.CreateList(ctx, opts))
+	if err != nil {
+		return err
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Created list %d.\n", listID))
+
+	return nil
+}
+
+func (a *TMDB) addToList(ctx context.Context, list, movie, comment string) error {
+	listID, err := a.parseID("List", list)
+	if err != nil {
+		return err
+	}
+
+	movieID, err := a.parseID("Movie", movie)
+	if err != nil {
+		return err
+	}
+
+	items := []tmdb.ListEntry{{MediaType: tmdb.MediaMovie, Comment: comment, MediaID: movieID}}
+
+	err = oops.Wrap(a.client.AddListItems(ctx, listID, items))
+	if err != nil {
+		return err
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Added %d to list %d.\n", movieID, listID))
+
+	return nil
+}
+
+func (a *TMDB) showList(ctx context.Context, list, operand string) error {
+	listID, err := a.parseID("List", list)
+	if err != nil {
+		return err
+	}
+
+	page, err := a.parsePage(operand)
+	if err != nil {
+		return err
+	}
+
+	data, err := oops.Wrap2(a.client.GetList(ctx, listID, page))
+	if err != nil {
+		return err
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "---- %q ----\n", data.Name))
+
+	if data.Description != "" {
+		fp.Silent(fmt.Fprintf(a.output, " > %s\n", data.Description))
+	}
+
+	if data.TotalResults == 0 {
+		fp.Silent(fmt.Fprintln(a.output, "Nothing in the list yet."))
+
+		return nil
+	}
+
+	for _, item := range data.Results {
+		fp.Silent(fmt.Fprintf(a.output, " * %s %d: %s (%s)\n", item.MediaType, item.ID, title(item), released(item)))
+
+		if comment := data.Comment(item); comment != "" {
+			fp.Silent(fmt.Fprintf(a.output, "   > %s\n", comment))
+		}
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Page %d of %d.\n", data.Page, data.TotalPages))
+
+	return nil
+}
+
+// exportList walks every page of the list, so the output holds the whole list or nothing but the error.
+func (a *TMDB) exportList(ctx context.Context, list string) error {
+	listID, err := a.parseID("List", list)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{{"media_type", "id", "title", "date", "comment"}}
+
+	for page, pages := 1, 1; page <= pages; page++ {
+		data, err := oops.Wrap2(a.client.GetList(ctx, listID, page))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range data.Results {
+			rows = append(rows, []string{
+				string(item.MediaType), strconv.Itoa(item.ID), title(item), released(item), data.Comment(item),
+			})
+		}
+
+		pages = data.TotalPages
+	}
+
+	return oops.Wrap(csv.NewWriter(a.output).WriteAll(rows))
+}
+
+func title(item tmdb.ListItem) string {
+	if item.MediaType == tmdb.MediaTV {
+		return item.Name
+	}
+
+	return item.Title
+}
+
+func released(item tmdb.ListItem) string {
+	if item.MediaType == tmdb.MediaTV {
+		return item.FirstAirDate
+	}
+
+	return item.ReleaseDate
+}
@@ -0,0 +1,187 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func listItem(media tmdb.MediaType, mediaID int, title, date string) tmdb.ListItem {
+	item := tmdb.ListItem{
+		MediaType:    media,
+		Title:        title,
+		Name:         "",
+		Overview:     "",
+		ReleaseDate:  date,
+		FirstAirDate: "",
+		Popularity:   0,
+		ID:           mediaID,
+		VoteCount:    0,
+	}
+
+	if media == tmdb.MediaTV {
+		item.Title, item.Name = "", title
+		item.ReleaseDate, item.FirstAirDate = "", date
+	}
+
+	return item
+}
+
+func listPage(page, pages int, items ...tmdb.ListItem) tmdb.List {
+	return tmdb.List{
+		Comments:     map[string]string{"movie:550": "again, with friends"},
+		Name:         "Weekend",
+		Description:  "To watch",
+		Language:     "en",
+		Results:      items,
+		ID:           7,
+		Page:         page,
+		TotalPages:   pages,
+		TotalResults: 3,
+		Public:       false,
+	}
+}
+
+func TestTMDBListCreateAndAdd(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("CreateList", mock.Anything, tmdb.ListOptions{
+		Name:        "Weekend",
+		Description: "To watch",
+		Language:    "",
+		Public:      false,
+	}).Once().Return(7, nil)
+	client.On("AddListItems", mock.Anything, 7, []tmdb.ListEntry{
+		{MediaType: tmdb.MediaMovie, Comment: "again", MediaID: 550},
+	}).Once().Return(nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.List(t.Context(), "create", "Weekend", "To watch")
+	obj.List(t.Context(), "add", "7", "550", "again")
+
+	assert.Equal(t, "Created list 7.\nAdded 550 to list 7.\n", output.String())
+}
+
+func TestTMDBListShow(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetList", mock.Anything, 7, 2).Once().Return(listPage(2, 2,
+		listItem(tmdb.MediaMovie, 550, "Fight Club", "1999-10-15"),
+		listItem(tmdb.MediaTV, 1396, "Breaking Bad", "2008-01-20"),
+	), nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.List(t.Context(), "show", "7", "2")
+
+	assert.Equal(t, `---- "Weekend" ----
+ > To watch
+ * movie 550: Fight Club (1999-10-15)
+   > again, with friends
+ * tv 1396: Breaking Bad (2008-01-20)
+Page 2 of 2.
+`, output.String())
+}
+
+func TestTMDBListExport(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetList", mock.Anything, 7, 1).Once().Return(listPage(1, 2,
+		listItem(tmdb.MediaMovie, 550, "Fight Club", "1999-10-15"),
+		listItem(tmdb.MediaTV, 1396, "Breaking Bad", "2008-01-20"),
+	), nil)
+	client.On("GetList", mock.Anything, 7, 2).Once().Return(listPage(2, 2,
+		listItem(tmdb.MediaMovie, 13, "Forrest Gump, Jr.", ""),
+	), nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.List(t.Context(), "export", "7")
+
+	assert.Equal(t, `media_type,id,title,date,comment
+movie,550,Fight Club,1999-10-15,"again, with friends"
+tv,1396,Breaking Bad,2008-01-20,
+movie,13,"Forrest Gump, Jr.",,
+`, output.String())
+}
+
+func TestTMDBListExportFailure(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetList", mock.Anything, 7, 1).Once().Return(listPage(1, 2), nil)
+	client.On("GetList", mock.Anything, 7, 2).Once().Return(*new(tmdb.List), errFail)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.List(t.Context(), "export", "7")
+
+	assert.Equal(t, "Something went wrong.\n", output.String())
+}
+
+func TestTMDBListFailure(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		action   string
+		operands []string
+	}
+
+	tests := []struct {
+		name string
+		want string
+		args args
+	}{
+		{
+			name: "unknown action",
+			args: args{action: "rm", operands: nil},
+			want: "Unknown list action. Allowed [create,add,show,export]\n",
+		},
+		{
+			name: "invalid list id",
+			args: args{action: "add", operands: []string{"weekend", "550"}},
+			want: "Invalid id: List ids are positive integers.\n",
+		},
+		{
+			name: "invalid movie id",
+			args: args{action: "add", operands: []string{"7"}},
+			want: "Invalid id: Movie ids are positive integers.\n",
+		},
+		{
+			name: "invalid page",
+			args: args{action: "show", operands: []string{"7", "last"}},
+			want: "Invalid page: Pages start at 1 and max at 500. They are expected to be an integer.\n",
+		},
+		{
+			name: "missing list id",
+			args: args{action: "export", operands: nil},
+			want: "Invalid id: List ids are positive integers.\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := new(strings.Builder)
+			obj := New().WithDependencies(output, mocks.NewMockClient(t))
+
+			obj.List(t.Context(), test.args.action, test.args.operands...)
+
+			assert.Equal(t, test.want, output.String())
+		})
+	}
+}
@@ -38,6 +38,144 @@ func (_m *MockClient) EXPECT() *MockClient_Expecter {
 	return &MockClient_Expecter{mock: &_m.Mock}
 }
 
+// AddListItems provides a mock function for the type MockClient
+func (_mock *MockClient) AddListItems(ctx context.Context, listID int, items []tmdb.ListEntry) error {
+	ret := _mock.Called(ctx, listID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddListItems")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, []tmdb.ListEntry) error); ok {
+		r0 = returnFunc(ctx, listID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_AddListItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddListItems'
+type MockClient_AddListItems_Call struct {
+	*mock.Call
+}
+
+// AddListItems is a helper method to define mock.On call
+//   - ctx
+//   - listID
+//   - items
+func (_e *MockClient_Expecter) AddListItems(ctx interface{}, listID interface{}, items interface{}) *MockClient_AddListItems_Call {
+	return &MockClient_AddListItems_Call{Call: _e.mock.On("AddListItems", ctx, listID, items)}
+}
+
+func (_c *MockClient_AddListItems_Call) Run(run func(ctx context.Context, listID int, items []tmdb.ListEntry)) *MockClient_AddListItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]tmdb.ListEntry))
+	})
+	return _c
+}
+
+func (_c *MockClient_AddListItems_Call) Return(err error) *MockClient_AddListItems_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_AddListItems_Call) RunAndReturn(run func(ctx context.Context, listID int, items []tmdb.ListEntry) error) *MockClient_AddListItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckLocale provides a mock function for the type MockClient
+func (_mock *MockClient) CheckLocale(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckLocale")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_CheckLocale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckLocale'
+type MockClient_CheckLocale_Call struct {
+	*mock.Call
+}
+
+// CheckLocale is a helper method to define mock.On call
+//   - ctx
+func (_e *MockClient_Expecter) CheckLocale(ctx interface{}) *MockClient_CheckLocale_Call {
+	return &MockClient_CheckLocale_Call{Call: _e.mock.On("CheckLocale", ctx)}
+}
+
+func (_c *MockClient_CheckLocale_Call) Run(run func(ctx context.Context)) *MockClient_CheckLocale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_CheckLocale_Call) Return(err error) *MockClient_CheckLocale_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_CheckLocale_Call) RunAndReturn(run func(ctx context.Context) error) *MockClient_CheckLocale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClearList provides a mock function for the type MockClient
+func (_mock *MockClient) ClearList(ctx context.Context, listID int) error {
+	ret := _mock.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearList")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, listID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_ClearList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearList'
+type MockClient_ClearList_Call struct {
+	*mock.Call
+}
+
+// ClearList is a helper method to define mock.On call
+//   - ctx
+//   - listID
+func (_e *MockClient_Expecter) ClearList(ctx interface{}, listID interface{}) *MockClient_ClearList_Call {
+	return &MockClient_ClearList_Call{Call: _e.mock.On("ClearList", ctx, listID)}
+}
+
+func (_c *MockClient_ClearList_Call) Run(run func(ctx context.Context, listID int)) *MockClient_ClearList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_ClearList_Call) Return(err error) *MockClient_ClearList_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_ClearList_Call) RunAndReturn(run func(ctx context.Context, listID int) error) *MockClient_ClearList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function for the type MockClient
 func (_mock *MockClient) Close() error {
 	ret := _mock.Called()
@@ -82,230 +220,2923 @@ func (_c *MockClient_Close_Call) RunAndReturn(run func() error) *MockClient_Clos
 	return _c
 }
 
-// GetNowPlayingMovies provides a mock function for the type MockClient
-func (_mock *MockClient) GetNowPlayingMovies(ctx context.Context, page int) ([]tmdb.Movie, error) {
-	ret := _mock.Called(ctx, page)
+// CreateAccessRequest provides a mock function for the type MockClient
+func (_mock *MockClient) CreateAccessRequest(ctx context.Context) (tmdb.AccessRequest, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNowPlayingMovies")
+		panic("no return value specified for CreateAccessRequest")
 	}
 
-	var r0 []tmdb.Movie
+	var r0 tmdb.AccessRequest
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]tmdb.Movie, error)); ok {
-		return returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (tmdb.AccessRequest, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []tmdb.Movie); ok {
-		r0 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) tmdb.AccessRequest); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]tmdb.Movie)
-		}
+		r0 = ret.Get(0).(tmdb.AccessRequest)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GetNowPlayingMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNowPlayingMovies'
-type MockClient_GetNowPlayingMovies_Call struct {
+// MockClient_CreateAccessRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccessRequest'
+type MockClient_CreateAccessRequest_Call struct {
 	*mock.Call
 }
 
-// GetNowPlayingMovies is a helper method to define mock.On call
+// CreateAccessRequest is a helper method to define mock.On call
 //   - ctx
-//   - page
-func (_e *MockClient_Expecter) GetNowPlayingMovies(ctx interface{}, page interface{}) *MockClient_GetNowPlayingMovies_Call {
-	return &MockClient_GetNowPlayingMovies_Call{Call: _e.mock.On("GetNowPlayingMovies", ctx, page)}
+func (_e *MockClient_Expecter) CreateAccessRequest(ctx interface{}) *MockClient_CreateAccessRequest_Call {
+	return &MockClient_CreateAccessRequest_Call{Call: _e.mock.On("CreateAccessRequest", ctx)}
 }
 
-func (_c *MockClient_GetNowPlayingMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetNowPlayingMovies_Call {
+func (_c *MockClient_CreateAccessRequest_Call) Run(run func(ctx context.Context)) *MockClient_CreateAccessRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetNowPlayingMovies_Call) Return(movies []tmdb.Movie, err error) *MockClient_GetNowPlayingMovies_Call {
-	_c.Call.Return(movies, err)
+func (_c *MockClient_CreateAccessRequest_Call) Return(accessRequest tmdb.AccessRequest, err error) *MockClient_CreateAccessRequest_Call {
+	_c.Call.Return(accessRequest, err)
 	return _c
 }
 
-func (_c *MockClient_GetNowPlayingMovies_Call) RunAndReturn(run func(ctx context.Context, page int) ([]tmdb.Movie, error)) *MockClient_GetNowPlayingMovies_Call {
+func (_c *MockClient_CreateAccessRequest_Call) RunAndReturn(run func(ctx context.Context) (tmdb.AccessRequest, error)) *MockClient_CreateAccessRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPopularMovies provides a mock function for the type MockClient
-func (_mock *MockClient) GetPopularMovies(ctx context.Context, page int) ([]tmdb.Movie, error) {
-	ret := _mock.Called(ctx, page)
+// CreateAccessToken provides a mock function for the type MockClient
+func (_mock *MockClient) CreateAccessToken(ctx context.Context, requestToken string) (tmdb.AccessToken, error) {
+	ret := _mock.Called(ctx, requestToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPopularMovies")
+		panic("no return value specified for CreateAccessToken")
 	}
 
-	var r0 []tmdb.Movie
+	var r0 tmdb.AccessToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]tmdb.Movie, error)); ok {
-		return returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (tmdb.AccessToken, error)); ok {
+		return returnFunc(ctx, requestToken)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []tmdb.Movie); ok {
-		r0 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) tmdb.AccessToken); ok {
+		r0 = returnFunc(ctx, requestToken)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]tmdb.Movie)
-		}
+		r0 = ret.Get(0).(tmdb.AccessToken)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, requestToken)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GetPopularMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPopularMovies'
-type MockClient_GetPopularMovies_Call struct {
+// MockClient_CreateAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAccessToken'
+type MockClient_CreateAccessToken_Call struct {
 	*mock.Call
 }
 
-// GetPopularMovies is a helper method to define mock.On call
+// CreateAccessToken is a helper method to define mock.On call
 //   - ctx
-//   - page
-func (_e *MockClient_Expecter) GetPopularMovies(ctx interface{}, page interface{}) *MockClient_GetPopularMovies_Call {
-	return &MockClient_GetPopularMovies_Call{Call: _e.mock.On("GetPopularMovies", ctx, page)}
+//   - requestToken
+func (_e *MockClient_Expecter) CreateAccessToken(ctx interface{}, requestToken interface{}) *MockClient_CreateAccessToken_Call {
+	return &MockClient_CreateAccessToken_Call{Call: _e.mock.On("CreateAccessToken", ctx, requestToken)}
 }
 
-func (_c *MockClient_GetPopularMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetPopularMovies_Call {
+func (_c *MockClient_CreateAccessToken_Call) Run(run func(ctx context.Context, requestToken string)) *MockClient_CreateAccessToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetPopularMovies_Call) Return(movies []tmdb.Movie, err error) *MockClient_GetPopularMovies_Call {
-	_c.Call.Return(movies, err)
+func (_c *MockClient_CreateAccessToken_Call) Return(accessToken tmdb.AccessToken, err error) *MockClient_CreateAccessToken_Call {
+	_c.Call.Return(accessToken, err)
 	return _c
 }
 
-func (_c *MockClient_GetPopularMovies_Call) RunAndReturn(run func(ctx context.Context, page int) ([]tmdb.Movie, error)) *MockClient_GetPopularMovies_Call {
+func (_c *MockClient_CreateAccessToken_Call) RunAndReturn(run func(ctx context.Context, requestToken string) (tmdb.AccessToken, error)) *MockClient_CreateAccessToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTopRatedMovies provides a mock function for the type MockClient
-func (_mock *MockClient) GetTopRatedMovies(ctx context.Context, page int) ([]tmdb.Movie, error) {
-	ret := _mock.Called(ctx, page)
+// CreateGuestSession provides a mock function for the type MockClient
+func (_mock *MockClient) CreateGuestSession(ctx context.Context) (tmdb.GuestSession, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTopRatedMovies")
+		panic("no return value specified for CreateGuestSession")
 	}
 
-	var r0 []tmdb.Movie
+	var r0 tmdb.GuestSession
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]tmdb.Movie, error)); ok {
-		return returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (tmdb.GuestSession, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []tmdb.Movie); ok {
-		r0 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) tmdb.GuestSession); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]tmdb.Movie)
-		}
+		r0 = ret.Get(0).(tmdb.GuestSession)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GetTopRatedMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopRatedMovies'
-type MockClient_GetTopRatedMovies_Call struct {
+// MockClient_CreateGuestSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGuestSession'
+type MockClient_CreateGuestSession_Call struct {
 	*mock.Call
 }
 
-// GetTopRatedMovies is a helper method to define mock.On call
+// CreateGuestSession is a helper method to define mock.On call
 //   - ctx
-//   - page
-func (_e *MockClient_Expecter) GetTopRatedMovies(ctx interface{}, page interface{}) *MockClient_GetTopRatedMovies_Call {
-	return &MockClient_GetTopRatedMovies_Call{Call: _e.mock.On("GetTopRatedMovies", ctx, page)}
+func (_e *MockClient_Expecter) CreateGuestSession(ctx interface{}) *MockClient_CreateGuestSession_Call {
+	return &MockClient_CreateGuestSession_Call{Call: _e.mock.On("CreateGuestSession", ctx)}
 }
 
-func (_c *MockClient_GetTopRatedMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetTopRatedMovies_Call {
+func (_c *MockClient_CreateGuestSession_Call) Run(run func(ctx context.Context)) *MockClient_CreateGuestSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetTopRatedMovies_Call) Return(movies []tmdb.Movie, err error) *MockClient_GetTopRatedMovies_Call {
-	_c.Call.Return(movies, err)
+func (_c *MockClient_CreateGuestSession_Call) Return(guestSession tmdb.GuestSession, err error) *MockClient_CreateGuestSession_Call {
+	_c.Call.Return(guestSession, err)
 	return _c
 }
 
-func (_c *MockClient_GetTopRatedMovies_Call) RunAndReturn(run func(ctx context.Context, page int) ([]tmdb.Movie, error)) *MockClient_GetTopRatedMovies_Call {
+func (_c *MockClient_CreateGuestSession_Call) RunAndReturn(run func(ctx context.Context) (tmdb.GuestSession, error)) *MockClient_CreateGuestSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUpcomingMovies provides a mock function for the type MockClient
-func (_mock *MockClient) GetUpcomingMovies(ctx context.Context, page int) ([]tmdb.Movie, error) {
-	ret := _mock.Called(ctx, page)
+// CreateList provides a mock function for the type MockClient
+func (_mock *MockClient) CreateList(ctx context.Context, opts tmdb.ListOptions) (int, error) {
+	ret := _mock.Called(ctx, opts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUpcomingMovies")
+		panic("no return value specified for CreateList")
 	}
 
-	var r0 []tmdb.Movie
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]tmdb.Movie, error)); ok {
-		return returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tmdb.ListOptions) (int, error)); ok {
+		return returnFunc(ctx, opts)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []tmdb.Movie); ok {
-		r0 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tmdb.ListOptions) int); ok {
+		r0 = returnFunc(ctx, opts)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]tmdb.Movie)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = returnFunc(ctx, page)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, tmdb.ListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GetUpcomingMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpcomingMovies'
-type MockClient_GetUpcomingMovies_Call struct {
+// MockClient_CreateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateList'
+type MockClient_CreateList_Call struct {
 	*mock.Call
 }
 
-// GetUpcomingMovies is a helper method to define mock.On call
+// CreateList is a helper method to define mock.On call
 //   - ctx
-//   - page
-func (_e *MockClient_Expecter) GetUpcomingMovies(ctx interface{}, page interface{}) *MockClient_GetUpcomingMovies_Call {
-	return &MockClient_GetUpcomingMovies_Call{Call: _e.mock.On("GetUpcomingMovies", ctx, page)}
+//   - opts
+func (_e *MockClient_Expecter) CreateList(ctx interface{}, opts interface{}) *MockClient_CreateList_Call {
+	return &MockClient_CreateList_Call{Call: _e.mock.On("CreateList", ctx, opts)}
 }
 
-func (_c *MockClient_GetUpcomingMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetUpcomingMovies_Call {
+func (_c *MockClient_CreateList_Call) Run(run func(ctx context.Context, opts tmdb.ListOptions)) *MockClient_CreateList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(tmdb.ListOptions))
 	})
 	return _c
 }
 
-func (_c *MockClient_GetUpcomingMovies_Call) Return(movies []tmdb.Movie, err error) *MockClient_GetUpcomingMovies_Call {
-	_c.Call.Return(movies, err)
+func (_c *MockClient_CreateList_Call) Return(v int, err error) *MockClient_CreateList_Call {
+	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockClient_GetUpcomingMovies_Call) RunAndReturn(run func(ctx context.Context, page int) ([]tmdb.Movie, error)) *MockClient_GetUpcomingMovies_Call {
+func (_c *MockClient_CreateList_Call) RunAndReturn(run func(ctx context.Context, opts tmdb.ListOptions) (int, error)) *MockClient_CreateList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRequestToken provides a mock function for the type MockClient
+func (_mock *MockClient) CreateRequestToken(ctx context.Context) (tmdb.RequestToken, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRequestToken")
+	}
+
+	var r0 tmdb.RequestToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (tmdb.RequestToken, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) tmdb.RequestToken); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(tmdb.RequestToken)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_CreateRequestToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRequestToken'
+type MockClient_CreateRequestToken_Call struct {
+	*mock.Call
+}
+
+// CreateRequestToken is a helper method to define mock.On call
+//   - ctx
+func (_e *MockClient_Expecter) CreateRequestToken(ctx interface{}) *MockClient_CreateRequestToken_Call {
+	return &MockClient_CreateRequestToken_Call{Call: _e.mock.On("CreateRequestToken", ctx)}
+}
+
+func (_c *MockClient_CreateRequestToken_Call) Run(run func(ctx context.Context)) *MockClient_CreateRequestToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_CreateRequestToken_Call) Return(requestToken tmdb.RequestToken, err error) *MockClient_CreateRequestToken_Call {
+	_c.Call.Return(requestToken, err)
+	return _c
+}
+
+func (_c *MockClient_CreateRequestToken_Call) RunAndReturn(run func(ctx context.Context) (tmdb.RequestToken, error)) *MockClient_CreateRequestToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSession provides a mock function for the type MockClient
+func (_mock *MockClient) CreateSession(ctx context.Context, requestToken string) (tmdb.Session, error) {
+	ret := _mock.Called(ctx, requestToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 tmdb.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (tmdb.Session, error)); ok {
+		return returnFunc(ctx, requestToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) tmdb.Session); ok {
+		r0 = returnFunc(ctx, requestToken)
+	} else {
+		r0 = ret.Get(0).(tmdb.Session)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, requestToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type MockClient_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx
+//   - requestToken
+func (_e *MockClient_Expecter) CreateSession(ctx interface{}, requestToken interface{}) *MockClient_CreateSession_Call {
+	return &MockClient_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, requestToken)}
+}
+
+func (_c *MockClient_CreateSession_Call) Run(run func(ctx context.Context, requestToken string)) *MockClient_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_CreateSession_Call) Return(session tmdb.Session, err error) *MockClient_CreateSession_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockClient_CreateSession_Call) RunAndReturn(run func(ctx context.Context, requestToken string) (tmdb.Session, error)) *MockClient_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSessionFromV4 provides a mock function for the type MockClient
+func (_mock *MockClient) CreateSessionFromV4(ctx context.Context, accessToken string) (tmdb.Session, error) {
+	ret := _mock.Called(ctx, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSessionFromV4")
+	}
+
+	var r0 tmdb.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (tmdb.Session, error)); ok {
+		return returnFunc(ctx, accessToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) tmdb.Session); ok {
+		r0 = returnFunc(ctx, accessToken)
+	} else {
+		r0 = ret.Get(0).(tmdb.Session)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_CreateSessionFromV4_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSessionFromV4'
+type MockClient_CreateSessionFromV4_Call struct {
+	*mock.Call
+}
+
+// CreateSessionFromV4 is a helper method to define mock.On call
+//   - ctx
+//   - accessToken
+func (_e *MockClient_Expecter) CreateSessionFromV4(ctx interface{}, accessToken interface{}) *MockClient_CreateSessionFromV4_Call {
+	return &MockClient_CreateSessionFromV4_Call{Call: _e.mock.On("CreateSessionFromV4", ctx, accessToken)}
+}
+
+func (_c *MockClient_CreateSessionFromV4_Call) Run(run func(ctx context.Context, accessToken string)) *MockClient_CreateSessionFromV4_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_CreateSessionFromV4_Call) Return(session tmdb.Session, err error) *MockClient_CreateSessionFromV4_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockClient_CreateSessionFromV4_Call) RunAndReturn(run func(ctx context.Context, accessToken string) (tmdb.Session, error)) *MockClient_CreateSessionFromV4_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccessToken provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteAccessToken(ctx context.Context, accessToken string) error {
+	ret := _mock.Called(ctx, accessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccessToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, accessToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccessToken'
+type MockClient_DeleteAccessToken_Call struct {
+	*mock.Call
+}
+
+// DeleteAccessToken is a helper method to define mock.On call
+//   - ctx
+//   - accessToken
+func (_e *MockClient_Expecter) DeleteAccessToken(ctx interface{}, accessToken interface{}) *MockClient_DeleteAccessToken_Call {
+	return &MockClient_DeleteAccessToken_Call{Call: _e.mock.On("DeleteAccessToken", ctx, accessToken)}
+}
+
+func (_c *MockClient_DeleteAccessToken_Call) Run(run func(ctx context.Context, accessToken string)) *MockClient_DeleteAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteAccessToken_Call) Return(err error) *MockClient_DeleteAccessToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteAccessToken_Call) RunAndReturn(run func(ctx context.Context, accessToken string) error) *MockClient_DeleteAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteList provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteList(ctx context.Context, listID int) error {
+	ret := _mock.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteList")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, listID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteList'
+type MockClient_DeleteList_Call struct {
+	*mock.Call
+}
+
+// DeleteList is a helper method to define mock.On call
+//   - ctx
+//   - listID
+func (_e *MockClient_Expecter) DeleteList(ctx interface{}, listID interface{}) *MockClient_DeleteList_Call {
+	return &MockClient_DeleteList_Call{Call: _e.mock.On("DeleteList", ctx, listID)}
+}
+
+func (_c *MockClient_DeleteList_Call) Run(run func(ctx context.Context, listID int)) *MockClient_DeleteList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteList_Call) Return(err error) *MockClient_DeleteList_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteList_Call) RunAndReturn(run func(ctx context.Context, listID int) error) *MockClient_DeleteList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMovieRating provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteMovieRating(ctx context.Context, movieID int) error {
+	ret := _mock.Called(ctx, movieID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMovieRating")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, movieID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteMovieRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMovieRating'
+type MockClient_DeleteMovieRating_Call struct {
+	*mock.Call
+}
+
+// DeleteMovieRating is a helper method to define mock.On call
+//   - ctx
+//   - movieID
+func (_e *MockClient_Expecter) DeleteMovieRating(ctx interface{}, movieID interface{}) *MockClient_DeleteMovieRating_Call {
+	return &MockClient_DeleteMovieRating_Call{Call: _e.mock.On("DeleteMovieRating", ctx, movieID)}
+}
+
+func (_c *MockClient_DeleteMovieRating_Call) Run(run func(ctx context.Context, movieID int)) *MockClient_DeleteMovieRating_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteMovieRating_Call) Return(err error) *MockClient_DeleteMovieRating_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteMovieRating_Call) RunAndReturn(run func(ctx context.Context, movieID int) error) *MockClient_DeleteMovieRating_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSession provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteSession(ctx context.Context, sessionID string) error {
+	ret := _mock.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSession'
+type MockClient_DeleteSession_Call struct {
+	*mock.Call
+}
+
+// DeleteSession is a helper method to define mock.On call
+//   - ctx
+//   - sessionID
+func (_e *MockClient_Expecter) DeleteSession(ctx interface{}, sessionID interface{}) *MockClient_DeleteSession_Call {
+	return &MockClient_DeleteSession_Call{Call: _e.mock.On("DeleteSession", ctx, sessionID)}
+}
+
+func (_c *MockClient_DeleteSession_Call) Run(run func(ctx context.Context, sessionID string)) *MockClient_DeleteSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteSession_Call) Return(err error) *MockClient_DeleteSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteSession_Call) RunAndReturn(run func(ctx context.Context, sessionID string) error) *MockClient_DeleteSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccount provides a mock function for the type MockClient
+func (_mock *MockClient) GetAccount(ctx context.Context) (tmdb.Account, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccount")
+	}
+
+	var r0 tmdb.Account
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (tmdb.Account, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) tmdb.Account); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(tmdb.Account)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccount'
+type MockClient_GetAccount_Call struct {
+	*mock.Call
+}
+
+// GetAccount is a helper method to define mock.On call
+//   - ctx
+func (_e *MockClient_Expecter) GetAccount(ctx interface{}) *MockClient_GetAccount_Call {
+	return &MockClient_GetAccount_Call{Call: _e.mock.On("GetAccount", ctx)}
+}
+
+func (_c *MockClient_GetAccount_Call) Run(run func(ctx context.Context)) *MockClient_GetAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetAccount_Call) Return(account tmdb.Account, err error) *MockClient_GetAccount_Call {
+	_c.Call.Return(account, err)
+	return _c
+}
+
+func (_c *MockClient_GetAccount_Call) RunAndReturn(run func(ctx context.Context) (tmdb.Account, error)) *MockClient_GetAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountStates provides a mock function for the type MockClient
+func (_mock *MockClient) GetAccountStates(ctx context.Context, movieID int) (tmdb.AccountStates, error) {
+	ret := _mock.Called(ctx, movieID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountStates")
+	}
+
+	var r0 tmdb.AccountStates
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (tmdb.AccountStates, error)); ok {
+		return returnFunc(ctx, movieID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) tmdb.AccountStates); ok {
+		r0 = returnFunc(ctx, movieID)
+	} else {
+		r0 = ret.Get(0).(tmdb.AccountStates)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, movieID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetAccountStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountStates'
+type MockClient_GetAccountStates_Call struct {
+	*mock.Call
+}
+
+// GetAccountStates is a helper method to define mock.On call
+//   - ctx
+//   - movieID
+func (_e *MockClient_Expecter) GetAccountStates(ctx interface{}, movieID interface{}) *MockClient_GetAccountStates_Call {
+	return &MockClient_GetAccountStates_Call{Call: _e.mock.On("GetAccountStates", ctx, movieID)}
+}
+
+func (_c *MockClient_GetAccountStates_Call) Run(run func(ctx context.Context, movieID int)) *MockClient_GetAccountStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetAccountStates_Call) Return(accountStates tmdb.AccountStates, err error) *MockClient_GetAccountStates_Call {
+	_c.Call.Return(accountStates, err)
+	return _c
+}
+
+func (_c *MockClient_GetAccountStates_Call) RunAndReturn(run func(ctx context.Context, movieID int) (tmdb.AccountStates, error)) *MockClient_GetAccountStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAggregateCredits provides a mock function for the type MockClient
+func (_mock *MockClient) GetAggregateCredits(ctx context.Context, seriesID int) (tmdb.AggregateCredits, error) {
+	ret := _mock.Called(ctx, seriesID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAggregateCredits")
+	}
+
+	var r0 tmdb.AggregateCredits
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (tmdb.AggregateCredits, error)); ok {
+		return returnFunc(ctx, seriesID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) tmdb.AggregateCredits); ok {
+		r0 = returnFunc(ctx, seriesID)
+	} else {
+		r0 = ret.Get(0).(tmdb.AggregateCredits)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, seriesID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetAggregateCredits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAggregateCredits'
+type MockClient_GetAggregateCredits_Call struct {
+	*mock.Call
+}
+
+// GetAggregateCredits is a helper method to define mock.On call
+//   - ctx
+//   - seriesID
+func (_e *MockClient_Expecter) GetAggregateCredits(ctx interface{}, seriesID interface{}) *MockClient_GetAggregateCredits_Call {
+	return &MockClient_GetAggregateCredits_Call{Call: _e.mock.On("GetAggregateCredits", ctx, seriesID)}
+}
+
+func (_c *MockClient_GetAggregateCredits_Call) Run(run func(ctx context.Context, seriesID int)) *MockClient_GetAggregateCredits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetAggregateCredits_Call) Return(aggregateCredits tmdb.AggregateCredits, err error) *MockClient_GetAggregateCredits_Call {
+	_c.Call.Return(aggregateCredits, err)
+	return _c
+}
+
+func (_c *MockClient_GetAggregateCredits_Call) RunAndReturn(run func(ctx context.Context, seriesID int) (tmdb.AggregateCredits, error)) *MockClient_GetAggregateCredits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlternativeTitles provides a mock function for the type MockClient
+func (_mock *MockClient) GetAlternativeTitles(ctx context.Context, movieID int, country string) ([]tmdb.AltTitle, error) {
+	ret := _mock.Called(ctx, movieID, country)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlternativeTitles")
+	}
+
+	var r0 []tmdb.AltTitle
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string) ([]tmdb.AltTitle, error)); ok {
+		return returnFunc(ctx, movieID, country)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string) []tmdb.AltTitle); ok {
+		r0 = returnFunc(ctx, movieID, country)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.AltTitle)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = returnFunc(ctx, movieID, country)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetAlternativeTitles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlternativeTitles'
+type MockClient_GetAlternativeTitles_Call struct {
+	*mock.Call
+}
+
+// GetAlternativeTitles is a helper method to define mock.On call
+//   - ctx
+//   - movieID
+//   - country
+func (_e *MockClient_Expecter) GetAlternativeTitles(ctx interface{}, movieID interface{}, country interface{}) *MockClient_GetAlternativeTitles_Call {
+	return &MockClient_GetAlternativeTitles_Call{Call: _e.mock.On("GetAlternativeTitles", ctx, movieID, country)}
+}
+
+func (_c *MockClient_GetAlternativeTitles_Call) Run(run func(ctx context.Context, movieID int, country string)) *MockClient_GetAlternativeTitles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetAlternativeTitles_Call) Return(altTitles []tmdb.AltTitle, err error) *MockClient_GetAlternativeTitles_Call {
+	_c.Call.Return(altTitles, err)
+	return _c
+}
+
+func (_c *MockClient_GetAlternativeTitles_Call) RunAndReturn(run func(ctx context.Context, movieID int, country string) ([]tmdb.AltTitle, error)) *MockClient_GetAlternativeTitles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChangedMovies provides a mock function for the type MockClient
+func (_mock *MockClient) GetChangedMovies(ctx context.Context, opts tmdb.ChangesOptions) (tmdb.Page[tmdb.ChangedItem], error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChangedMovies")
+	}
+
+	var r0 tmdb.Page[tmdb.ChangedItem]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tmdb.ChangesOptions) (tmdb.Page[tmdb.ChangedItem], error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tmdb.ChangesOptions) tmdb.Page[tmdb.ChangedItem]); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		r0 = ret.Get(0).(tmdb.Page[tmdb.ChangedItem])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, tmdb.ChangesOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetChangedMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChangedMovies'
+type MockClient_GetChangedMovies_Call struct {
+	*mock.Call
+}
+
+// GetChangedMovies is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockClient_Expecter) GetChangedMovies(ctx interface{}, opts interface{}) *MockClient_GetChangedMovies_Call {
+	return &MockClient_GetChangedMovies_Call{Call: _e.mock.On("GetChangedMovies", ctx, opts)}
+}
+
+func (_c *MockClient_GetChangedMovies_Call) Run(run func(ctx context.Context, opts tmdb.ChangesOptions)) *MockClient_GetChangedMovies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tmdb.ChangesOptions))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetChangedMovies_Call) Return(page tmdb.Page[tmdb.ChangedItem], err error) *MockClient_GetChangedMovies_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockClient_GetChangedMovies_Call) RunAndReturn(run func(ctx context.Context, opts tmdb.ChangesOptions) (tmdb.Page[tmdb.ChangedItem], error)) *MockClient_GetChangedMovies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChangedPeople provides a mock function for the type MockClient
+func (_mock *MockClient) GetChangedPeople(ctx context.Context, opts tmdb.ChangesOptions) (tmdb.Page[tmdb.ChangedItem], error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChangedPeople")
+	}
+
+	var r0 tmdb.Page[tmdb.ChangedItem]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tmdb.ChangesOptions) (tmdb.Page[tmdb.ChangedItem], error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tmdb.ChangesOptions) tmdb.Page[tmdb.ChangedItem]); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		r0 = ret.Get(0).(tmdb.Page[tmdb.ChangedItem])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, tmdb.ChangesOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetChangedPeople_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChangedPeople'
+type MockClient_GetChangedPeople_Call struct {
+	*mock.Call
+}
+
+// GetChangedPeople is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockClient_Expecter) GetChangedPeople(ctx interface{}, opts interface{}) *MockClient_GetChangedPeople_Call {
+	return &MockClient_GetChangedPeople_Call{Call: _e.mock.On("GetChangedPeople", ctx, opts)}
+}
+
+func (_c *MockClient_GetChangedPeople_Call) Run(run func(ctx context.Context, opts tmdb.ChangesOptions)) *MockClient_GetChangedPeople_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tmdb.ChangesOptions))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetChangedPeople_Call) Return(page tmdb.Page[tmdb.ChangedItem], err error) *MockClient_GetChangedPeople_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockClient_GetChangedPeople_Call) RunAndReturn(run func(ctx context.Context, opts tmdb.ChangesOptions) (tmdb.Page[tmdb.ChangedItem], error)) *MockClient_GetChangedPeople_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChangedTV provides a mock function for the type MockClient
+func (_mock *MockClient) GetChangedTV(ctx context.Context, opts tmdb.ChangesOptions) (tmdb.Page[tmdb.ChangedItem], error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChangedTV")
+	}
+
+	var r0 tmdb.Page[tmdb.ChangedItem]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tmdb.ChangesOptions) (tmdb.Page[tmdb.ChangedItem], error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tmdb.ChangesOptions) tmdb.Page[tmdb.ChangedItem]); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		r0 = ret.Get(0).(tmdb.Page[tmdb.ChangedItem])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, tmdb.ChangesOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetChangedTV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChangedTV'
+type MockClient_GetChangedTV_Call struct {
+	*mock.Call
+}
+
+// GetChangedTV is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockClient_Expecter) GetChangedTV(ctx interface{}, opts interface{}) *MockClient_GetChangedTV_Call {
+	return &MockClient_GetChangedTV_Call{Call: _e.mock.On("GetChangedTV", ctx, opts)}
+}
+
+func (_c *MockClient_GetChangedTV_Call) Run(run func(ctx context.Context, opts tmdb.ChangesOptions)) *MockClient_GetChangedTV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tmdb.ChangesOptions))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetChangedTV_Call) Return(page tmdb.Page[tmdb.ChangedItem], err error) *MockClient_GetChangedTV_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockClient_GetChangedTV_Call) RunAndReturn(run func(ctx context.Context, opts tmdb.ChangesOptions) (tmdb.Page[tmdb.ChangedItem], error)) *MockClient_GetChangedTV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCountries provides a mock function for the type MockClient
+func (_mock *MockClient) GetCountries(ctx context.Context) ([]tmdb.Country, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCountries")
+	}
+
+	var r0 []tmdb.Country
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]tmdb.Country, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []tmdb.Country); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Country)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetCountries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCountries'
+type MockClient_GetCountries_Call struct {
+	*mock.Call
+}
+
+// GetCountries is a helper method to define mock.On call
+//   - ctx
+func (_e *MockClient_Expecter) GetCountries(ctx interface{}) *MockClient_GetCountries_Call {
+	return &MockClient_GetCountries_Call{Call: _e.mock.On("GetCountries", ctx)}
+}
+
+func (_c *MockClient_GetCountries_Call) Run(run func(ctx context.Context)) *MockClient_GetCountries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetCountries_Call) Return(countrys []tmdb.Country, err error) *MockClient_GetCountries_Call {
+	_c.Call.Return(countrys, err)
+	return _c
+}
+
+func (_c *MockClient_GetCountries_Call) RunAndReturn(run func(ctx context.Context) ([]tmdb.Country, error)) *MockClient_GetCountries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavoriteMovies provides a mock function for the type MockClient
+func (_mock *MockClient) GetFavoriteMovies(ctx context.Context, page int) (tmdb.MoviesPage, error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavoriteMovies")
+	}
+
+	var r0 tmdb.MoviesPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (tmdb.MoviesPage, error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) tmdb.MoviesPage); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.MoviesPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetFavoriteMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavoriteMovies'
+type MockClient_GetFavoriteMovies_Call struct {
+	*mock.Call
+}
+
+// GetFavoriteMovies is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockClient_Expecter) GetFavoriteMovies(ctx interface{}, page interface{}) *MockClient_GetFavoriteMovies_Call {
+	return &MockClient_GetFavoriteMovies_Call{Call: _e.mock.On("GetFavoriteMovies", ctx, page)}
+}
+
+func (_c *MockClient_GetFavoriteMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetFavoriteMovies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetFavoriteMovies_Call) Return(moviesPage tmdb.MoviesPage, err error) *MockClient_GetFavoriteMovies_Call {
+	_c.Call.Return(moviesPage, err)
+	return _c
+}
+
+func (_c *MockClient_GetFavoriteMovies_Call) RunAndReturn(run func(ctx context.Context, page int) (tmdb.MoviesPage, error)) *MockClient_GetFavoriteMovies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFavoriteTV provides a mock function for the type MockClient
+func (_mock *MockClient) GetFavoriteTV(ctx context.Context, page int) (tmdb.Page[tmdb.TVShow], error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFavoriteTV")
+	}
+
+	var r0 tmdb.Page[tmdb.TVShow]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (tmdb.Page[tmdb.TVShow], error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) tmdb.Page[tmdb.TVShow]); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.Page[tmdb.TVShow])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetFavoriteTV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFavoriteTV'
+type MockClient_GetFavoriteTV_Call struct {
+	*mock.Call
+}
+
+// GetFavoriteTV is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockClient_Expecter) GetFavoriteTV(ctx interface{}, page interface{}) *MockClient_GetFavoriteTV_Call {
+	return &MockClient_GetFavoriteTV_Call{Call: _e.mock.On("GetFavoriteTV", ctx, page)}
+}
+
+func (_c *MockClient_GetFavoriteTV_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetFavoriteTV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetFavoriteTV_Call) Return(page tmdb.Page[tmdb.TVShow], err error) *MockClient_GetFavoriteTV_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockClient_GetFavoriteTV_Call) RunAndReturn(run func(ctx context.Context, page int) (tmdb.Page[tmdb.TVShow], error)) *MockClient_GetFavoriteTV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLanguages provides a mock function for the type MockClient
+func (_mock *MockClient) GetLanguages(ctx context.Context) ([]tmdb.Language, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLanguages")
+	}
+
+	var r0 []tmdb.Language
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]tmdb.Language, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []tmdb.Language); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Language)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetLanguages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLanguages'
+type MockClient_GetLanguages_Call struct {
+	*mock.Call
+}
+
+// GetLanguages is a helper method to define mock.On call
+//   - ctx
+func (_e *MockClient_Expecter) GetLanguages(ctx interface{}) *MockClient_GetLanguages_Call {
+	return &MockClient_GetLanguages_Call{Call: _e.mock.On("GetLanguages", ctx)}
+}
+
+func (_c *MockClient_GetLanguages_Call) Run(run func(ctx context.Context)) *MockClient_GetLanguages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetLanguages_Call) Return(languages []tmdb.Language, err error) *MockClient_GetLanguages_Call {
+	_c.Call.Return(languages, err)
+	return _c
+}
+
+func (_c *MockClient_GetLanguages_Call) RunAndReturn(run func(ctx context.Context) ([]tmdb.Language, error)) *MockClient_GetLanguages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestMovie provides a mock function for the type MockClient
+func (_mock *MockClient) GetLatestMovie(ctx context.Context) (tmdb.MovieDetails, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestMovie")
+	}
+
+	var r0 tmdb.MovieDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (tmdb.MovieDetails, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) tmdb.MovieDetails); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(tmdb.MovieDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetLatestMovie_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestMovie'
+type MockClient_GetLatestMovie_Call struct {
+	*mock.Call
+}
+
+// GetLatestMovie is a helper method to define mock.On call
+//   - ctx
+func (_e *MockClient_Expecter) GetLatestMovie(ctx interface{}) *MockClient_GetLatestMovie_Call {
+	return &MockClient_GetLatestMovie_Call{Call: _e.mock.On("GetLatestMovie", ctx)}
+}
+
+func (_c *MockClient_GetLatestMovie_Call) Run(run func(ctx context.Context)) *MockClient_GetLatestMovie_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetLatestMovie_Call) Return(movieDetails tmdb.MovieDetails, err error) *MockClient_GetLatestMovie_Call {
+	_c.Call.Return(movieDetails, err)
+	return _c
+}
+
+func (_c *MockClient_GetLatestMovie_Call) RunAndReturn(run func(ctx context.Context) (tmdb.MovieDetails, error)) *MockClient_GetLatestMovie_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetList provides a mock function for the type MockClient
+func (_mock *MockClient) GetList(ctx context.Context, listID int, page int) (tmdb.List, error) {
+	ret := _mock.Called(ctx, listID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetList")
+	}
+
+	var r0 tmdb.List
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) (tmdb.List, error)); ok {
+		return returnFunc(ctx, listID, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) tmdb.List); ok {
+		r0 = returnFunc(ctx, listID, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.List)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, listID, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetList'
+type MockClient_GetList_Call struct {
+	*mock.Call
+}
+
+// GetList is a helper method to define mock.On call
+//   - ctx
+//   - listID
+//   - page
+func (_e *MockClient_Expecter) GetList(ctx interface{}, listID interface{}, page interface{}) *MockClient_GetList_Call {
+	return &MockClient_GetList_Call{Call: _e.mock.On("GetList", ctx, listID, page)}
+}
+
+func (_c *MockClient_GetList_Call) Run(run func(ctx context.Context, listID int, page int)) *MockClient_GetList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetList_Call) Return(list tmdb.List, err error) *MockClient_GetList_Call {
+	_c.Call.Return(list, err)
+	return _c
+}
+
+func (_c *MockClient_GetList_Call) RunAndReturn(run func(ctx context.Context, listID int, page int) (tmdb.List, error)) *MockClient_GetList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMovieChanges provides a mock function for the type MockClient
+func (_mock *MockClient) GetMovieChanges(ctx context.Context, movieID int, opts tmdb.ChangesOptions) ([]tmdb.Change, error) {
+	ret := _mock.Called(ctx, movieID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMovieChanges")
+	}
+
+	var r0 []tmdb.Change
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.ChangesOptions) ([]tmdb.Change, error)); ok {
+		return returnFunc(ctx, movieID, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.ChangesOptions) []tmdb.Change); ok {
+		r0 = returnFunc(ctx, movieID, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Change)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, tmdb.ChangesOptions) error); ok {
+		r1 = returnFunc(ctx, movieID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetMovieChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMovieChanges'
+type MockClient_GetMovieChanges_Call struct {
+	*mock.Call
+}
+
+// GetMovieChanges is a helper method to define mock.On call
+//   - ctx
+//   - movieID
+//   - opts
+func (_e *MockClient_Expecter) GetMovieChanges(ctx interface{}, movieID interface{}, opts interface{}) *MockClient_GetMovieChanges_Call {
+	return &MockClient_GetMovieChanges_Call{Call: _e.mock.On("GetMovieChanges", ctx, movieID, opts)}
+}
+
+func (_c *MockClient_GetMovieChanges_Call) Run(run func(ctx context.Context, movieID int, opts tmdb.ChangesOptions)) *MockClient_GetMovieChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(tmdb.ChangesOptions))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetMovieChanges_Call) Return(changes []tmdb.Change, err error) *MockClient_GetMovieChanges_Call {
+	_c.Call.Return(changes, err)
+	return _c
+}
+
+func (_c *MockClient_GetMovieChanges_Call) RunAndReturn(run func(ctx context.Context, movieID int, opts tmdb.ChangesOptions) ([]tmdb.Change, error)) *MockClient_GetMovieChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMovieDetails provides a mock function for the type MockClient
+func (_mock *MockClient) GetMovieDetails(ctx context.Context, movieID int, opts tmdb.DetailsOptions) (tmdb.MovieDetails, error) {
+	ret := _mock.Called(ctx, movieID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMovieDetails")
+	}
+
+	var r0 tmdb.MovieDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.DetailsOptions) (tmdb.MovieDetails, error)); ok {
+		return returnFunc(ctx, movieID, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.DetailsOptions) tmdb.MovieDetails); ok {
+		r0 = returnFunc(ctx, movieID, opts)
+	} else {
+		r0 = ret.Get(0).(tmdb.MovieDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, tmdb.DetailsOptions) error); ok {
+		r1 = returnFunc(ctx, movieID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetMovieDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMovieDetails'
+type MockClient_GetMovieDetails_Call struct {
+	*mock.Call
+}
+
+// GetMovieDetails is a helper method to define mock.On call
+//   - ctx
+//   - movieID
+//   - opts
+func (_e *MockClient_Expecter) GetMovieDetails(ctx interface{}, movieID interface{}, opts interface{}) *MockClient_GetMovieDetails_Call {
+	return &MockClient_GetMovieDetails_Call{Call: _e.mock.On("GetMovieDetails", ctx, movieID, opts)}
+}
+
+func (_c *MockClient_GetMovieDetails_Call) Run(run func(ctx context.Context, movieID int, opts tmdb.DetailsOptions)) *MockClient_GetMovieDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(tmdb.DetailsOptions))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetMovieDetails_Call) Return(movieDetails tmdb.MovieDetails, err error) *MockClient_GetMovieDetails_Call {
+	_c.Call.Return(movieDetails, err)
+	return _c
+}
+
+func (_c *MockClient_GetMovieDetails_Call) RunAndReturn(run func(ctx context.Context, movieID int, opts tmdb.DetailsOptions) (tmdb.MovieDetails, error)) *MockClient_GetMovieDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMovieImages provides a mock function for the type MockClient
+func (_mock *MockClient) GetMovieImages(ctx context.Context, movieID int, opts tmdb.ImageOptions) (tmdb.Images, error) {
+	ret := _mock.Called(ctx, movieID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMovieImages")
+	}
+
+	var r0 tmdb.Images
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.ImageOptions) (tmdb.Images, error)); ok {
+		return returnFunc(ctx, movieID, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.ImageOptions) tmdb.Images); ok {
+		r0 = returnFunc(ctx, movieID, opts)
+	} else {
+		r0 = ret.Get(0).(tmdb.Images)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, tmdb.ImageOptions) error); ok {
+		r1 = returnFunc(ctx, movieID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetMovieImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMovieImages'
+type MockClient_GetMovieImages_Call struct {
+	*mock.Call
+}
+
+// GetMovieImages is a helper method to define mock.On call
+//   - ctx
+//   - movieID
+//   - opts
+func (_e *MockClient_Expecter) GetMovieImages(ctx interface{}, movieID interface{}, opts interface{}) *MockClient_GetMovieImages_Call {
+	return &MockClient_GetMovieImages_Call{Call: _e.mock.On("GetMovieImages", ctx, movieID, opts)}
+}
+
+func (_c *MockClient_GetMovieImages_Call) Run(run func(ctx context.Context, movieID int, opts tmdb.ImageOptions)) *MockClient_GetMovieImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(tmdb.ImageOptions))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetMovieImages_Call) Return(images tmdb.Images, err error) *MockClient_GetMovieImages_Call {
+	_c.Call.Return(images, err)
+	return _c
+}
+
+func (_c *MockClient_GetMovieImages_Call) RunAndReturn(run func(ctx context.Context, movieID int, opts tmdb.ImageOptions) (tmdb.Images, error)) *MockClient_GetMovieImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNowPlayingMovies provides a mock function for the type MockClient
+func (_mock *MockClient) GetNowPlayingMovies(ctx context.Context, page int) ([]tmdb.Movie, error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNowPlayingMovies")
+	}
+
+	var r0 []tmdb.Movie
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]tmdb.Movie, error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []tmdb.Movie); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Movie)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetNowPlayingMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNowPlayingMovies'
+type MockClient_GetNowPlayingMovies_Call struct {
+	*mock.Call
+}
+
+// GetNowPlayingMovies is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockClient_Expecter) GetNowPlayingMovies(ctx interface{}, page interface{}) *MockClient_GetNowPlayingMovies_Call {
+	return &MockClient_GetNowPlayingMovies_Call{Call: _e.mock.On("GetNowPlayingMovies", ctx, page)}
+}
+
+func (_c *MockClient_GetNowPlayingMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetNowPlayingMovies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetNowPlayingMovies_Call) Return(movies []tmdb.Movie, err error) *MockClient_GetNowPlayingMovies_Call {
+	_c.Call.Return(movies, err)
+	return _c
+}
+
+func (_c *MockClient_GetNowPlayingMovies_Call) RunAndReturn(run func(ctx context.Context, page int) ([]tmdb.Movie, error)) *MockClient_GetNowPlayingMovies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonChanges provides a mock function for the type MockClient
+func (_mock *MockClient) GetPersonChanges(ctx context.Context, personID int, opts tmdb.ChangesOptions) ([]tmdb.Change, error) {
+	ret := _mock.Called(ctx, personID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonChanges")
+	}
+
+	var r0 []tmdb.Change
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.ChangesOptions) ([]tmdb.Change, error)); ok {
+		return returnFunc(ctx, personID, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.ChangesOptions) []tmdb.Change); ok {
+		r0 = returnFunc(ctx, personID, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Change)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, tmdb.ChangesOptions) error); ok {
+		r1 = returnFunc(ctx, personID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetPersonChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonChanges'
+type MockClient_GetPersonChanges_Call struct {
+	*mock.Call
+}
+
+// GetPersonChanges is a helper method to define mock.On call
+//   - ctx
+//   - personID
+//   - opts
+func (_e *MockClient_Expecter) GetPersonChanges(ctx interface{}, personID interface{}, opts interface{}) *MockClient_GetPersonChanges_Call {
+	return &MockClient_GetPersonChanges_Call{Call: _e.mock.On("GetPersonChanges", ctx, personID, opts)}
+}
+
+func (_c *MockClient_GetPersonChanges_Call) Run(run func(ctx context.Context, personID int, opts tmdb.ChangesOptions)) *MockClient_GetPersonChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(tmdb.ChangesOptions))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetPersonChanges_Call) Return(changes []tmdb.Change, err error) *MockClient_GetPersonChanges_Call {
+	_c.Call.Return(changes, err)
+	return _c
+}
+
+func (_c *MockClient_GetPersonChanges_Call) RunAndReturn(run func(ctx context.Context, personID int, opts tmdb.ChangesOptions) ([]tmdb.Change, error)) *MockClient_GetPersonChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersonImages provides a mock function for the type MockClient
+func (_mock *MockClient) GetPersonImages(ctx context.Context, personID int) ([]tmdb.Image, error) {
+	ret := _mock.Called(ctx, personID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPersonImages")
+	}
+
+	var r0 []tmdb.Image
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]tmdb.Image, error)); ok {
+		return returnFunc(ctx, personID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []tmdb.Image); ok {
+		r0 = returnFunc(ctx, personID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Image)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, personID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetPersonImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersonImages'
+type MockClient_GetPersonImages_Call struct {
+	*mock.Call
+}
+
+// GetPersonImages is a helper method to define mock.On call
+//   - ctx
+//   - personID
+func (_e *MockClient_Expecter) GetPersonImages(ctx interface{}, personID interface{}) *MockClient_GetPersonImages_Call {
+	return &MockClient_GetPersonImages_Call{Call: _e.mock.On("GetPersonImages", ctx, personID)}
+}
+
+func (_c *MockClient_GetPersonImages_Call) Run(run func(ctx context.Context, personID int)) *MockClient_GetPersonImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetPersonImages_Call) Return(images []tmdb.Image, err error) *MockClient_GetPersonImages_Call {
+	_c.Call.Return(images, err)
+	return _c
+}
+
+func (_c *MockClient_GetPersonImages_Call) RunAndReturn(run func(ctx context.Context, personID int) ([]tmdb.Image, error)) *MockClient_GetPersonImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPopularMovies provides a mock function for the type MockClient
+func (_mock *MockClient) GetPopularMovies(ctx context.Context, page int) ([]tmdb.Movie, error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPopularMovies")
+	}
+
+	var r0 []tmdb.Movie
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]tmdb.Movie, error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []tmdb.Movie); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Movie)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetPopularMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPopularMovies'
+type MockClient_GetPopularMovies_Call struct {
+	*mock.Call
+}
+
+// GetPopularMovies is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockClient_Expecter) GetPopularMovies(ctx interface{}, page interface{}) *MockClient_GetPopularMovies_Call {
+	return &MockClient_GetPopularMovies_Call{Call: _e.mock.On("GetPopularMovies", ctx, page)}
+}
+
+func (_c *MockClient_GetPopularMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetPopularMovies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetPopularMovies_Call) Return(movies []tmdb.Movie, err error) *MockClient_GetPopularMovies_Call {
+	_c.Call.Return(movies, err)
+	return _c
+}
+
+func (_c *MockClient_GetPopularMovies_Call) RunAndReturn(run func(ctx context.Context, page int) ([]tmdb.Movie, error)) *MockClient_GetPopularMovies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrimaryTranslations provides a mock function for the type MockClient
+func (_mock *MockClient) GetPrimaryTranslations(ctx context.Context) ([]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrimaryTranslations")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetPrimaryTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrimaryTranslations'
+type MockClient_GetPrimaryTranslations_Call struct {
+	*mock.Call
+}
+
+// GetPrimaryTranslations is a helper method to define mock.On call
+//   - ctx
+func (_e *MockClient_Expecter) GetPrimaryTranslations(ctx interface{}) *MockClient_GetPrimaryTranslations_Call {
+	return &MockClient_GetPrimaryTranslations_Call{Call: _e.mock.On("GetPrimaryTranslations", ctx)}
+}
+
+func (_c *MockClient_GetPrimaryTranslations_Call) Run(run func(ctx context.Context)) *MockClient_GetPrimaryTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetPrimaryTranslations_Call) Return(vs []string, err error) *MockClient_GetPrimaryTranslations_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_GetPrimaryTranslations_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *MockClient_GetPrimaryTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRatedMovies provides a mock function for the type MockClient
+func (_mock *MockClient) GetRatedMovies(ctx context.Context, page int) (tmdb.Page[tmdb.RatedMovie], error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRatedMovies")
+	}
+
+	var r0 tmdb.Page[tmdb.RatedMovie]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (tmdb.Page[tmdb.RatedMovie], error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) tmdb.Page[tmdb.RatedMovie]); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.Page[tmdb.RatedMovie])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetRatedMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRatedMovies'
+type MockClient_GetRatedMovies_Call struct {
+	*mock.Call
+}
+
+// GetRatedMovies is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockClient_Expecter) GetRatedMovies(ctx interface{}, page interface{}) *MockClient_GetRatedMovies_Call {
+	return &MockClient_GetRatedMovies_Call{Call: _e.mock.On("GetRatedMovies", ctx, page)}
+}
+
+func (_c *MockClient_GetRatedMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetRatedMovies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetRatedMovies_Call) Return(page tmdb.Page[tmdb.RatedMovie], err error) *MockClient_GetRatedMovies_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockClient_GetRatedMovies_Call) RunAndReturn(run func(ctx context.Context, page int) (tmdb.Page[tmdb.RatedMovie], error)) *MockClient_GetRatedMovies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRatedTV provides a mock function for the type MockClient
+func (_mock *MockClient) GetRatedTV(ctx context.Context, page int) (tmdb.Page[tmdb.RatedTVShow], error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRatedTV")
+	}
+
+	var r0 tmdb.Page[tmdb.RatedTVShow]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (tmdb.Page[tmdb.RatedTVShow], error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) tmdb.Page[tmdb.RatedTVShow]); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.Page[tmdb.RatedTVShow])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetRatedTV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRatedTV'
+type MockClient_GetRatedTV_Call struct {
+	*mock.Call
+}
+
+// GetRatedTV is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockClient_Expecter) GetRatedTV(ctx interface{}, page interface{}) *MockClient_GetRatedTV_Call {
+	return &MockClient_GetRatedTV_Call{Call: _e.mock.On("GetRatedTV", ctx, page)}
+}
+
+func (_c *MockClient_GetRatedTV_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetRatedTV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetRatedTV_Call) Return(page tmdb.Page[tmdb.RatedTVShow], err error) *MockClient_GetRatedTV_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockClient_GetRatedTV_Call) RunAndReturn(run func(ctx context.Context, page int) (tmdb.Page[tmdb.RatedTVShow], error)) *MockClient_GetRatedTV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTVChanges provides a mock function for the type MockClient
+func (_mock *MockClient) GetTVChanges(ctx context.Context, seriesID int, opts tmdb.ChangesOptions) ([]tmdb.Change, error) {
+	ret := _mock.Called(ctx, seriesID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTVChanges")
+	}
+
+	var r0 []tmdb.Change
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.ChangesOptions) ([]tmdb.Change, error)); ok {
+		return returnFunc(ctx, seriesID, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.ChangesOptions) []tmdb.Change); ok {
+		r0 = returnFunc(ctx, seriesID, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Change)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, tmdb.ChangesOptions) error); ok {
+		r1 = returnFunc(ctx, seriesID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetTVChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTVChanges'
+type MockClient_GetTVChanges_Call struct {
+	*mock.Call
+}
+
+// GetTVChanges is a helper method to define mock.On call
+//   - ctx
+//   - seriesID
+//   - opts
+func (_e *MockClient_Expecter) GetTVChanges(ctx interface{}, seriesID interface{}, opts interface{}) *MockClient_GetTVChanges_Call {
+	return &MockClient_GetTVChanges_Call{Call: _e.mock.On("GetTVChanges", ctx, seriesID, opts)}
+}
+
+func (_c *MockClient_GetTVChanges_Call) Run(run func(ctx context.Context, seriesID int, opts tmdb.ChangesOptions)) *MockClient_GetTVChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(tmdb.ChangesOptions))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTVChanges_Call) Return(changes []tmdb.Change, err error) *MockClient_GetTVChanges_Call {
+	_c.Call.Return(changes, err)
+	return _c
+}
+
+func (_c *MockClient_GetTVChanges_Call) RunAndReturn(run func(ctx context.Context, seriesID int, opts tmdb.ChangesOptions) ([]tmdb.Change, error)) *MockClient_GetTVChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTVEpisode provides a mock function for the type MockClient
+func (_mock *MockClient) GetTVEpisode(ctx context.Context, seriesID int, season int, episode int) (tmdb.Episode, error) {
+	ret := _mock.Called(ctx, seriesID, season, episode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTVEpisode")
+	}
+
+	var r0 tmdb.Episode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int) (tmdb.Episode, error)); ok {
+		return returnFunc(ctx, seriesID, season, episode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int) tmdb.Episode); ok {
+		r0 = returnFunc(ctx, seriesID, season, episode)
+	} else {
+		r0 = ret.Get(0).(tmdb.Episode)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = returnFunc(ctx, seriesID, season, episode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetTVEpisode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTVEpisode'
+type MockClient_GetTVEpisode_Call struct {
+	*mock.Call
+}
+
+// GetTVEpisode is a helper method to define mock.On call
+//   - ctx
+//   - seriesID
+//   - season
+//   - episode
+func (_e *MockClient_Expecter) GetTVEpisode(ctx interface{}, seriesID interface{}, season interface{}, episode interface{}) *MockClient_GetTVEpisode_Call {
+	return &MockClient_GetTVEpisode_Call{Call: _e.mock.On("GetTVEpisode", ctx, seriesID, season, episode)}
+}
+
+func (_c *MockClient_GetTVEpisode_Call) Run(run func(ctx context.Context, seriesID int, season int, episode int)) *MockClient_GetTVEpisode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTVEpisode_Call) Return(episode tmdb.Episode, err error) *MockClient_GetTVEpisode_Call {
+	_c.Call.Return(episode, err)
+	return _c
+}
+
+func (_c *MockClient_GetTVEpisode_Call) RunAndReturn(run func(ctx context.Context, seriesID int, season int, episode int) (tmdb.Episode, error)) *MockClient_GetTVEpisode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTVSeason provides a mock function for the type MockClient
+func (_mock *MockClient) GetTVSeason(ctx context.Context, seriesID int, season int) (tmdb.Season, error) {
+	ret := _mock.Called(ctx, seriesID, season)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTVSeason")
+	}
+
+	var r0 tmdb.Season
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) (tmdb.Season, error)); ok {
+		return returnFunc(ctx, seriesID, season)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) tmdb.Season); ok {
+		r0 = returnFunc(ctx, seriesID, season)
+	} else {
+		r0 = ret.Get(0).(tmdb.Season)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = returnFunc(ctx, seriesID, season)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetTVSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTVSeason'
+type MockClient_GetTVSeason_Call struct {
+	*mock.Call
+}
+
+// GetTVSeason is a helper method to define mock.On call
+//   - ctx
+//   - seriesID
+//   - season
+func (_e *MockClient_Expecter) GetTVSeason(ctx interface{}, seriesID interface{}, season interface{}) *MockClient_GetTVSeason_Call {
+	return &MockClient_GetTVSeason_Call{Call: _e.mock.On("GetTVSeason", ctx, seriesID, season)}
+}
+
+func (_c *MockClient_GetTVSeason_Call) Run(run func(ctx context.Context, seriesID int, season int)) *MockClient_GetTVSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTVSeason_Call) Return(season tmdb.Season, err error) *MockClient_GetTVSeason_Call {
+	_c.Call.Return(season, err)
+	return _c
+}
+
+func (_c *MockClient_GetTVSeason_Call) RunAndReturn(run func(ctx context.Context, seriesID int, season int) (tmdb.Season, error)) *MockClient_GetTVSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTVShowDetails provides a mock function for the type MockClient
+func (_mock *MockClient) GetTVShowDetails(ctx context.Context, seriesID int) (tmdb.TVShowDetails, error) {
+	ret := _mock.Called(ctx, seriesID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTVShowDetails")
+	}
+
+	var r0 tmdb.TVShowDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (tmdb.TVShowDetails, error)); ok {
+		return returnFunc(ctx, seriesID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) tmdb.TVShowDetails); ok {
+		r0 = returnFunc(ctx, seriesID)
+	} else {
+		r0 = ret.Get(0).(tmdb.TVShowDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, seriesID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetTVShowDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTVShowDetails'
+type MockClient_GetTVShowDetails_Call struct {
+	*mock.Call
+}
+
+// GetTVShowDetails is a helper method to define mock.On call
+//   - ctx
+//   - seriesID
+func (_e *MockClient_Expecter) GetTVShowDetails(ctx interface{}, seriesID interface{}) *MockClient_GetTVShowDetails_Call {
+	return &MockClient_GetTVShowDetails_Call{Call: _e.mock.On("GetTVShowDetails", ctx, seriesID)}
+}
+
+func (_c *MockClient_GetTVShowDetails_Call) Run(run func(ctx context.Context, seriesID int)) *MockClient_GetTVShowDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTVShowDetails_Call) Return(tVShowDetails tmdb.TVShowDetails, err error) *MockClient_GetTVShowDetails_Call {
+	_c.Call.Return(tVShowDetails, err)
+	return _c
+}
+
+func (_c *MockClient_GetTVShowDetails_Call) RunAndReturn(run func(ctx context.Context, seriesID int) (tmdb.TVShowDetails, error)) *MockClient_GetTVShowDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTimezones provides a mock function for the type MockClient
+func (_mock *MockClient) GetTimezones(ctx context.Context) ([]tmdb.Timezones, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimezones")
+	}
+
+	var r0 []tmdb.Timezones
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]tmdb.Timezones, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []tmdb.Timezones); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Timezones)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetTimezones_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimezones'
+type MockClient_GetTimezones_Call struct {
+	*mock.Call
+}
+
+// GetTimezones is a helper method to define mock.On call
+//   - ctx
+func (_e *MockClient_Expecter) GetTimezones(ctx interface{}) *MockClient_GetTimezones_Call {
+	return &MockClient_GetTimezones_Call{Call: _e.mock.On("GetTimezones", ctx)}
+}
+
+func (_c *MockClient_GetTimezones_Call) Run(run func(ctx context.Context)) *MockClient_GetTimezones_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTimezones_Call) Return(timezoness []tmdb.Timezones, err error) *MockClient_GetTimezones_Call {
+	_c.Call.Return(timezoness, err)
+	return _c
+}
+
+func (_c *MockClient_GetTimezones_Call) RunAndReturn(run func(ctx context.Context) ([]tmdb.Timezones, error)) *MockClient_GetTimezones_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTopRatedMovies provides a mock function for the type MockClient
+func (_mock *MockClient) GetTopRatedMovies(ctx context.Context, page int) ([]tmdb.Movie, error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTopRatedMovies")
+	}
+
+	var r0 []tmdb.Movie
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]tmdb.Movie, error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []tmdb.Movie); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Movie)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetTopRatedMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTopRatedMovies'
+type MockClient_GetTopRatedMovies_Call struct {
+	*mock.Call
+}
+
+// GetTopRatedMovies is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockClient_Expecter) GetTopRatedMovies(ctx interface{}, page interface{}) *MockClient_GetTopRatedMovies_Call {
+	return &MockClient_GetTopRatedMovies_Call{Call: _e.mock.On("GetTopRatedMovies", ctx, page)}
+}
+
+func (_c *MockClient_GetTopRatedMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetTopRatedMovies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTopRatedMovies_Call) Return(movies []tmdb.Movie, err error) *MockClient_GetTopRatedMovies_Call {
+	_c.Call.Return(movies, err)
+	return _c
+}
+
+func (_c *MockClient_GetTopRatedMovies_Call) RunAndReturn(run func(ctx context.Context, page int) ([]tmdb.Movie, error)) *MockClient_GetTopRatedMovies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTranslations provides a mock function for the type MockClient
+func (_mock *MockClient) GetTranslations(ctx context.Context, movieID int) ([]tmdb.Translation, error) {
+	ret := _mock.Called(ctx, movieID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTranslations")
+	}
+
+	var r0 []tmdb.Translation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]tmdb.Translation, error)); ok {
+		return returnFunc(ctx, movieID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []tmdb.Translation); ok {
+		r0 = returnFunc(ctx, movieID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Translation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, movieID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetTranslations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTranslations'
+type MockClient_GetTranslations_Call struct {
+	*mock.Call
+}
+
+// GetTranslations is a helper method to define mock.On call
+//   - ctx
+//   - movieID
+func (_e *MockClient_Expecter) GetTranslations(ctx interface{}, movieID interface{}) *MockClient_GetTranslations_Call {
+	return &MockClient_GetTranslations_Call{Call: _e.mock.On("GetTranslations", ctx, movieID)}
+}
+
+func (_c *MockClient_GetTranslations_Call) Run(run func(ctx context.Context, movieID int)) *MockClient_GetTranslations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetTranslations_Call) Return(translations []tmdb.Translation, err error) *MockClient_GetTranslations_Call {
+	_c.Call.Return(translations, err)
+	return _c
+}
+
+func (_c *MockClient_GetTranslations_Call) RunAndReturn(run func(ctx context.Context, movieID int) ([]tmdb.Translation, error)) *MockClient_GetTranslations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUpcomingMovies provides a mock function for the type MockClient
+func (_mock *MockClient) GetUpcomingMovies(ctx context.Context, page int) ([]tmdb.Movie, error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpcomingMovies")
+	}
+
+	var r0 []tmdb.Movie
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]tmdb.Movie, error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []tmdb.Movie); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tmdb.Movie)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetUpcomingMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpcomingMovies'
+type MockClient_GetUpcomingMovies_Call struct {
+	*mock.Call
+}
+
+// GetUpcomingMovies is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockClient_Expecter) GetUpcomingMovies(ctx interface{}, page interface{}) *MockClient_GetUpcomingMovies_Call {
+	return &MockClient_GetUpcomingMovies_Call{Call: _e.mock.On("GetUpcomingMovies", ctx, page)}
+}
+
+func (_c *MockClient_GetUpcomingMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetUpcomingMovies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetUpcomingMovies_Call) Return(movies []tmdb.Movie, err error) *MockClient_GetUpcomingMovies_Call {
+	_c.Call.Return(movies, err)
+	return _c
+}
+
+func (_c *MockClient_GetUpcomingMovies_Call) RunAndReturn(run func(ctx context.Context, page int) ([]tmdb.Movie, error)) *MockClient_GetUpcomingMovies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWatchlistMovies provides a mock function for the type MockClient
+func (_mock *MockClient) GetWatchlistMovies(ctx context.Context, page int) (tmdb.MoviesPage, error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWatchlistMovies")
+	}
+
+	var r0 tmdb.MoviesPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (tmdb.MoviesPage, error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) tmdb.MoviesPage); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.MoviesPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetWatchlistMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWatchlistMovies'
+type MockClient_GetWatchlistMovies_Call struct {
+	*mock.Call
+}
+
+// GetWatchlistMovies is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockClient_Expecter) GetWatchlistMovies(ctx interface{}, page interface{}) *MockClient_GetWatchlistMovies_Call {
+	return &MockClient_GetWatchlistMovies_Call{Call: _e.mock.On("GetWatchlistMovies", ctx, page)}
+}
+
+func (_c *MockClient_GetWatchlistMovies_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetWatchlistMovies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetWatchlistMovies_Call) Return(moviesPage tmdb.MoviesPage, err error) *MockClient_GetWatchlistMovies_Call {
+	_c.Call.Return(moviesPage, err)
+	return _c
+}
+
+func (_c *MockClient_GetWatchlistMovies_Call) RunAndReturn(run func(ctx context.Context, page int) (tmdb.MoviesPage, error)) *MockClient_GetWatchlistMovies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWatchlistTV provides a mock function for the type MockClient
+func (_mock *MockClient) GetWatchlistTV(ctx context.Context, page int) (tmdb.Page[tmdb.TVShow], error) {
+	ret := _mock.Called(ctx, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWatchlistTV")
+	}
+
+	var r0 tmdb.Page[tmdb.TVShow]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (tmdb.Page[tmdb.TVShow], error)); ok {
+		return returnFunc(ctx, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) tmdb.Page[tmdb.TVShow]); ok {
+		r0 = returnFunc(ctx, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.Page[tmdb.TVShow])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetWatchlistTV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWatchlistTV'
+type MockClient_GetWatchlistTV_Call struct {
+	*mock.Call
+}
+
+// GetWatchlistTV is a helper method to define mock.On call
+//   - ctx
+//   - page
+func (_e *MockClient_Expecter) GetWatchlistTV(ctx interface{}, page interface{}) *MockClient_GetWatchlistTV_Call {
+	return &MockClient_GetWatchlistTV_Call{Call: _e.mock.On("GetWatchlistTV", ctx, page)}
+}
+
+func (_c *MockClient_GetWatchlistTV_Call) Run(run func(ctx context.Context, page int)) *MockClient_GetWatchlistTV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetWatchlistTV_Call) Return(page tmdb.Page[tmdb.TVShow], err error) *MockClient_GetWatchlistTV_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockClient_GetWatchlistTV_Call) RunAndReturn(run func(ctx context.Context, page int) (tmdb.Page[tmdb.TVShow], error)) *MockClient_GetWatchlistTV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RateMovie provides a mock function for the type MockClient
+func (_mock *MockClient) RateMovie(ctx context.Context, movieID int, value float64) error {
+	ret := _mock.Called(ctx, movieID, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RateMovie")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, float64) error); ok {
+		r0 = returnFunc(ctx, movieID, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_RateMovie_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RateMovie'
+type MockClient_RateMovie_Call struct {
+	*mock.Call
+}
+
+// RateMovie is a helper method to define mock.On call
+//   - ctx
+//   - movieID
+//   - value
+func (_e *MockClient_Expecter) RateMovie(ctx interface{}, movieID interface{}, value interface{}) *MockClient_RateMovie_Call {
+	return &MockClient_RateMovie_Call{Call: _e.mock.On("RateMovie", ctx, movieID, value)}
+}
+
+func (_c *MockClient_RateMovie_Call) Run(run func(ctx context.Context, movieID int, value float64)) *MockClient_RateMovie_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *MockClient_RateMovie_Call) Return(err error) *MockClient_RateMovie_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_RateMovie_Call) RunAndReturn(run func(ctx context.Context, movieID int, value float64) error) *MockClient_RateMovie_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveListItems provides a mock function for the type MockClient
+func (_mock *MockClient) RemoveListItems(ctx context.Context, listID int, items []tmdb.ListEntry) error {
+	ret := _mock.Called(ctx, listID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveListItems")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, []tmdb.ListEntry) error); ok {
+		r0 = returnFunc(ctx, listID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_RemoveListItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveListItems'
+type MockClient_RemoveListItems_Call struct {
+	*mock.Call
+}
+
+// RemoveListItems is a helper method to define mock.On call
+//   - ctx
+//   - listID
+//   - items
+func (_e *MockClient_Expecter) RemoveListItems(ctx interface{}, listID interface{}, items interface{}) *MockClient_RemoveListItems_Call {
+	return &MockClient_RemoveListItems_Call{Call: _e.mock.On("RemoveListItems", ctx, listID, items)}
+}
+
+func (_c *MockClient_RemoveListItems_Call) Run(run func(ctx context.Context, listID int, items []tmdb.ListEntry)) *MockClient_RemoveListItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]tmdb.ListEntry))
+	})
+	return _c
+}
+
+func (_c *MockClient_RemoveListItems_Call) Return(err error) *MockClient_RemoveListItems_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_RemoveListItems_Call) RunAndReturn(run func(ctx context.Context, listID int, items []tmdb.ListEntry) error) *MockClient_RemoveListItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchMovies provides a mock function for the type MockClient
+func (_mock *MockClient) SearchMovies(ctx context.Context, query string, page int) (tmdb.MoviesPage, error) {
+	ret := _mock.Called(ctx, query, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchMovies")
+	}
+
+	var r0 tmdb.MoviesPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (tmdb.MoviesPage, error)); ok {
+		return returnFunc(ctx, query, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) tmdb.MoviesPage); ok {
+		r0 = returnFunc(ctx, query, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.MoviesPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, query, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_SearchMovies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMovies'
+type MockClient_SearchMovies_Call struct {
+	*mock.Call
+}
+
+// SearchMovies is a helper method to define mock.On call
+//   - ctx
+//   - query
+//   - page
+func (_e *MockClient_Expecter) SearchMovies(ctx interface{}, query interface{}, page interface{}) *MockClient_SearchMovies_Call {
+	return &MockClient_SearchMovies_Call{Call: _e.mock.On("SearchMovies", ctx, query, page)}
+}
+
+func (_c *MockClient_SearchMovies_Call) Run(run func(ctx context.Context, query string, page int)) *MockClient_SearchMovies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_SearchMovies_Call) Return(moviesPage tmdb.MoviesPage, err error) *MockClient_SearchMovies_Call {
+	_c.Call.Return(moviesPage, err)
+	return _c
+}
+
+func (_c *MockClient_SearchMovies_Call) RunAndReturn(run func(ctx context.Context, query string, page int) (tmdb.MoviesPage, error)) *MockClient_SearchMovies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchMulti provides a mock function for the type MockClient
+func (_mock *MockClient) SearchMulti(ctx context.Context, query string, page int) (tmdb.Page[tmdb.SearchResult], error) {
+	ret := _mock.Called(ctx, query, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchMulti")
+	}
+
+	var r0 tmdb.Page[tmdb.SearchResult]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (tmdb.Page[tmdb.SearchResult], error)); ok {
+		return returnFunc(ctx, query, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) tmdb.Page[tmdb.SearchResult]); ok {
+		r0 = returnFunc(ctx, query, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.Page[tmdb.SearchResult])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, query, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_SearchMulti_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMulti'
+type MockClient_SearchMulti_Call struct {
+	*mock.Call
+}
+
+// SearchMulti is a helper method to define mock.On call
+//   - ctx
+//   - query
+//   - page
+func (_e *MockClient_Expecter) SearchMulti(ctx interface{}, query interface{}, page interface{}) *MockClient_SearchMulti_Call {
+	return &MockClient_SearchMulti_Call{Call: _e.mock.On("SearchMulti", ctx, query, page)}
+}
+
+func (_c *MockClient_SearchMulti_Call) Run(run func(ctx context.Context, query string, page int)) *MockClient_SearchMulti_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_SearchMulti_Call) Return(page tmdb.Page[tmdb.SearchResult], err error) *MockClient_SearchMulti_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockClient_SearchMulti_Call) RunAndReturn(run func(ctx context.Context, query string, page int) (tmdb.Page[tmdb.SearchResult], error)) *MockClient_SearchMulti_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchPeople provides a mock function for the type MockClient
+func (_mock *MockClient) SearchPeople(ctx context.Context, query string, page int) (tmdb.Page[tmdb.Person], error) {
+	ret := _mock.Called(ctx, query, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchPeople")
+	}
+
+	var r0 tmdb.Page[tmdb.Person]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (tmdb.Page[tmdb.Person], error)); ok {
+		return returnFunc(ctx, query, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) tmdb.Page[tmdb.Person]); ok {
+		r0 = returnFunc(ctx, query, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.Page[tmdb.Person])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, query, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_SearchPeople_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchPeople'
+type MockClient_SearchPeople_Call struct {
+	*mock.Call
+}
+
+// SearchPeople is a helper method to define mock.On call
+//   - ctx
+//   - query
+//   - page
+func (_e *MockClient_Expecter) SearchPeople(ctx interface{}, query interface{}, page interface{}) *MockClient_SearchPeople_Call {
+	return &MockClient_SearchPeople_Call{Call: _e.mock.On("SearchPeople", ctx, query, page)}
+}
+
+func (_c *MockClient_SearchPeople_Call) Run(run func(ctx context.Context, query string, page int)) *MockClient_SearchPeople_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_SearchPeople_Call) Return(page tmdb.Page[tmdb.Person], err error) *MockClient_SearchPeople_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockClient_SearchPeople_Call) RunAndReturn(run func(ctx context.Context, query string, page int) (tmdb.Page[tmdb.Person], error)) *MockClient_SearchPeople_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTV provides a mock function for the type MockClient
+func (_mock *MockClient) SearchTV(ctx context.Context, query string, page int) (tmdb.Page[tmdb.TVShow], error) {
+	ret := _mock.Called(ctx, query, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTV")
+	}
+
+	var r0 tmdb.Page[tmdb.TVShow]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (tmdb.Page[tmdb.TVShow], error)); ok {
+		return returnFunc(ctx, query, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) tmdb.Page[tmdb.TVShow]); ok {
+		r0 = returnFunc(ctx, query, page)
+	} else {
+		r0 = ret.Get(0).(tmdb.Page[tmdb.TVShow])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, query, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_SearchTV_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTV'
+type MockClient_SearchTV_Call struct {
+	*mock.Call
+}
+
+// SearchTV is a helper method to define mock.On call
+//   - ctx
+//   - query
+//   - page
+func (_e *MockClient_Expecter) SearchTV(ctx interface{}, query interface{}, page interface{}) *MockClient_SearchTV_Call {
+	return &MockClient_SearchTV_Call{Call: _e.mock.On("SearchTV", ctx, query, page)}
+}
+
+func (_c *MockClient_SearchTV_Call) Run(run func(ctx context.Context, query string, page int)) *MockClient_SearchTV_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockClient_SearchTV_Call) Return(page tmdb.Page[tmdb.TVShow], err error) *MockClient_SearchTV_Call {
+	_c.Call.Return(page, err)
+	return _c
+}
+
+func (_c *MockClient_SearchTV_Call) RunAndReturn(run func(ctx context.Context, query string, page int) (tmdb.Page[tmdb.TVShow], error)) *MockClient_SearchTV_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFavorite provides a mock function for the type MockClient
+func (_mock *MockClient) SetFavorite(ctx context.Context, media tmdb.MediaType, mediaID int, favorite bool) error {
+	ret := _mock.Called(ctx, media, mediaID, favorite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFavorite")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tmdb.MediaType, int, bool) error); ok {
+		r0 = returnFunc(ctx, media, mediaID, favorite)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_SetFavorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFavorite'
+type MockClient_SetFavorite_Call struct {
+	*mock.Call
+}
+
+// SetFavorite is a helper method to define mock.On call
+//   - ctx
+//   - media
+//   - mediaID
+//   - favorite
+func (_e *MockClient_Expecter) SetFavorite(ctx interface{}, media interface{}, mediaID interface{}, favorite interface{}) *MockClient_SetFavorite_Call {
+	return &MockClient_SetFavorite_Call{Call: _e.mock.On("SetFavorite", ctx, media, mediaID, favorite)}
+}
+
+func (_c *MockClient_SetFavorite_Call) Run(run func(ctx context.Context, media tmdb.MediaType, mediaID int, favorite bool)) *MockClient_SetFavorite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tmdb.MediaType), args[2].(int), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetFavorite_Call) Return(err error) *MockClient_SetFavorite_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_SetFavorite_Call) RunAndReturn(run func(ctx context.Context, media tmdb.MediaType, mediaID int, favorite bool) error) *MockClient_SetFavorite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetWatchlist provides a mock function for the type MockClient
+func (_mock *MockClient) SetWatchlist(ctx context.Context, media tmdb.MediaType, mediaID int, watchlist bool) error {
+	ret := _mock.Called(ctx, media, mediaID, watchlist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetWatchlist")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, tmdb.MediaType, int, bool) error); ok {
+		r0 = returnFunc(ctx, media, mediaID, watchlist)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_SetWatchlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWatchlist'
+type MockClient_SetWatchlist_Call struct {
+	*mock.Call
+}
+
+// SetWatchlist is a helper method to define mock.On call
+//   - ctx
+//   - media
+//   - mediaID
+//   - watchlist
+func (_e *MockClient_Expecter) SetWatchlist(ctx interface{}, media interface{}, mediaID interface{}, watchlist interface{}) *MockClient_SetWatchlist_Call {
+	return &MockClient_SetWatchlist_Call{Call: _e.mock.On("SetWatchlist", ctx, media, mediaID, watchlist)}
+}
+
+func (_c *MockClient_SetWatchlist_Call) Run(run func(ctx context.Context, media tmdb.MediaType, mediaID int, watchlist bool)) *MockClient_SetWatchlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tmdb.MediaType), args[2].(int), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetWatchlist_Call) Return(err error) *MockClient_SetWatchlist_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_SetWatchlist_Call) RunAndReturn(run func(ctx context.Context, media tmdb.MediaType, mediaID int, watchlist bool) error) *MockClient_SetWatchlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateList provides a mock function for the type MockClient
+func (_mock *MockClient) UpdateList(ctx context.Context, listID int, opts tmdb.ListOptions) error {
+	ret := _mock.Called(ctx, listID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateList")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, tmdb.ListOptions) error); ok {
+		r0 = returnFunc(ctx, listID, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_UpdateList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateList'
+type MockClient_UpdateList_Call struct {
+	*mock.Call
+}
+
+// UpdateList is a helper method to define mock.On call
+//   - ctx
+//   - listID
+//   - opts
+func (_e *MockClient_Expecter) UpdateList(ctx interface{}, listID interface{}, opts interface{}) *MockClient_UpdateList_Call {
+	return &MockClient_UpdateList_Call{Call: _e.mock.On("UpdateList", ctx, listID, opts)}
+}
+
+func (_c *MockClient_UpdateList_Call) Run(run func(ctx context.Context, listID int, opts tmdb.ListOptions)) *MockClient_UpdateList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(tmdb.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockClient_UpdateList_Call) Return(err error) *MockClient_UpdateList_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_UpdateList_Call) RunAndReturn(run func(ctx context.Context, listID int, opts tmdb.ListOptions) error) *MockClient_UpdateList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateListItems provides a mock function for the type MockClient
+func (_mock *MockClient) UpdateListItems(ctx context.Context, listID int, items []tmdb.ListEntry) error {
+	ret := _mock.Called(ctx, listID, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateListItems")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, []tmdb.ListEntry) error); ok {
+		r0 = returnFunc(ctx, listID, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_UpdateListItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateListItems'
+type MockClient_UpdateListItems_Call struct {
+	*mock.Call
+}
+
+// UpdateListItems is a helper method to define mock.On call
+//   - ctx
+//   - listID
+//   - items
+func (_e *MockClient_Expecter) UpdateListItems(ctx interface{}, listID interface{}, items interface{}) *MockClient_UpdateListItems_Call {
+	return &MockClient_UpdateListItems_Call{Call: _e.mock.On("UpdateListItems", ctx, listID, items)}
+}
+
+func (_c *MockClient_UpdateListItems_Call) Run(run func(ctx context.Context, listID int, items []tmdb.ListEntry)) *MockClient_UpdateListItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]tmdb.ListEntry))
+	})
+	return _c
+}
+
+func (_c *MockClient_UpdateListItems_Call) Return(err error) *MockClient_UpdateListItems_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_UpdateListItems_Call) RunAndReturn(run func(ctx context.Context, listID int, items []tmdb.ListEntry) error) *MockClient_UpdateListItems_Call {
 	_c.Call.Return(run)
 	return _c
 }
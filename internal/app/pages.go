@@ -0,0 +1,64 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const concurrency = 4
+
+func (a *TMDB) FetchPages(ctx context.Context, pages, kind string) {
+	fetcher := a.Select(kind)
+	if fetcher == nil {
+		a.report(a.unknownType())
+
+		return
+	}
+
+	first, last, err := a.parsePages(pages)
+	if err != nil {
+		a.report(err)
+
+		return
+	}
+
+	for _, result := range tmdb.FetchPages(ctx, tmdb.PageFunc(fetcher), first, last, concurrency) {
+		fp.Silent(fmt.Fprintf(a.output, "\n==== Page %d ====\n", result.Page))
+
+		if result.Err != nil {
+			a.report(oops.Wrap(result.Err))
+
+			continue
+		}
+
+		for _, movie := range result.Movies {
+			a.print(movie)
+		}
+	}
+}
+
+func (a *TMDB) parsePages(pages string) (int, int, error) {
+	head, tail, found := strings.Cut(pages, "-")
+	if !found {
+		tail = head
+	}
+
+	first, errFirst := strconv.Atoi(strings.TrimSpace(head))
+	last, errLast := strconv.Atoi(strings.TrimSpace(tail))
+
+	if errFirst != nil || errLast != nil || first < 1 || last < first || last > tmdb.MaxPages {
+		return 0, 0, a.oops.Code(errUnexpected).
+			With("pages", pages).
+			Public(`Invalid pages: Expected a range like "1-10". Pages start at 1 and max at 500.`).
+			New("invalid pages range")
+	}
+
+	return first, last, nil
+}
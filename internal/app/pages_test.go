@@ -0,0 +1,108 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func TestTMDBFetchPagesSuccess(t *testing.T) {
+	t.Parallel()
+
+	want := `
+==== Page 1 ====
+---- "title1" ----
+ * Released: releaseDate1
+ * Votes: 100
+ * Popularity: 12.34
+ > overview1
+---- "title2" ----
+ * Released: releaseDate2
+ * Votes: 200
+ * Popularity: 56.78
+ > overview2
+
+==== Page 2 ====
+Something went wrong.
+
+==== Page 3 ====
+`
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetPopularMovies", mock.Anything, 1).Return(movies(), nil)
+	client.On("GetPopularMovies", mock.Anything, 2).Return(nil, errFail)
+	client.On("GetPopularMovies", mock.Anything, 3).Return([]tmdb.Movie{}, nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.FetchPages(t.Context(), "1-3", "popular")
+
+	assert.Equal(t, want, output.String())
+}
+
+func TestTMDBFetchPagesInvalidKind(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	obj := New().WithDependencies(output)
+
+	obj.FetchPages(t.Context(), "1-10", "invalid")
+
+	assert.Equal(t, "Unknown \"-type\" value for fetch. Allowed [playing,popular,top,upcoming]\n", output.String())
+}
+
+func TestTMDBFetchPagesInvalidRange(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		pages string
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{name: "garbage", args: args{pages: "one-two"}},
+		{name: "zero", args: args{pages: "0-2"}},
+		{name: "reversed", args: args{pages: "10-1"}},
+		{name: "too far", args: args{pages: "499-501"}},
+		{name: "open", args: args{pages: "1-"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := new(strings.Builder)
+			obj := New().WithDependencies(output)
+
+			obj.FetchPages(t.Context(), test.args.pages, "top")
+
+			assert.Equal(
+				t,
+				"Invalid pages: Expected a range like \"1-10\". Pages start at 1 and max at 500.\n",
+				output.String(),
+			)
+		})
+	}
+}
+
+func TestTMDBFetchPagesSinglePage(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetTopRatedMovies", mock.Anything, 7).Return([]tmdb.Movie{}, nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.FetchPages(t.Context(), "7", "top")
+
+	assert.Equal(t, "\n==== Page 7 ====\n", output.String())
+}
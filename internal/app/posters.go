@@ -0,0 +1,65 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	postersHeader = "LANGUAGE\tPOSTER\tSIZE\tURL\n"
+
+	// posterSize is the size of the poster URLs, the original file is the one fit for print.
+	posterSize = "original"
+)
+
+// Posters prints the best poster of the movie for each of languages, like `de` or `pt-BR`, the client language when
+// there are none. A language without a poster of its own gets one without text, if there is any.
+func (a *TMDB) Posters(ctx context.Context, operand string, languages ...string) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	movieID, err := a.parseID("Movie", operand)
+	if err != nil {
+		return
+	}
+
+	if len(languages) == 0 {
+		languages = []string{cmp.Or(a.settings.Config.Language, "en")}
+	}
+
+	// the empty language brings the posters without text to fall back on
+	opts := tmdb.ImageOptions{Languages: slices.Concat(languages, []string{""})}
+
+	images, err := oops.Wrap2(a.client.GetMovieImages(ctx, movieID, opts))
+	if err != nil {
+		return
+	}
+
+	table := tabwriter.NewWriter(a.output, tableMinWidth, tableTabWidth, tablePadding, ' ', 0)
+
+	fp.Silent(fmt.Fprint(table, postersHeader))
+
+	for _, language := range languages {
+		poster, found := images.BestPoster(language)
+
+		if !found {
+			fp.Silent(fmt.Fprintf(table, "%s\t-\t-\t-\n", language))
+
+			continue
+		}
+
+		fp.Silent(fmt.Fprintf(table, "%s\t%s\t%dx%d\t%s\n",
+			language, cmp.Or(poster.Language, "no text"), poster.Width, poster.Height, poster.URL(posterSize)))
+	}
+
+	_ = table.Flush()
+}
@@ -0,0 +1,75 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func image(path, language string, width, height int) tmdb.Image {
+	return tmdb.Image{
+		FilePath:    path,
+		Language:    language,
+		AspectRatio: 0.667,
+		VoteAverage: 5.3,
+		VoteCount:   4,
+		Width:       width,
+		Height:      height,
+	}
+}
+
+func images() tmdb.Images {
+	return tmdb.Images{Backdrops: nil, Logos: nil, Posters: []tmdb.Image{
+		image("/de.jpg", "de", 1000, 1500),
+		image("/none.jpg", "", 2000, 3000),
+	}}
+}
+
+func TestTMDBPosters(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetMovieImages", mock.Anything, 550, tmdb.ImageOptions{Languages: []string{"de-AT", "ja", ""}}).
+		Return(images(), nil)
+
+	New().WithDependencies(output, client).Posters(t.Context(), "550", "de-AT", "ja")
+
+	assert.Equal(t, `LANGUAGE  POSTER   SIZE       URL
+de-AT     de       1000x1500  https://image.tmdb.org/t/p/original/de.jpg
+ja        no text  2000x3000  https://image.tmdb.org/t/p/original/none.jpg
+`, output.String())
+}
+
+func TestTMDBPostersDefaultLanguage(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetMovieImages", mock.Anything, 550, tmdb.ImageOptions{Languages: []string{"en", ""}}).
+		Return(tmdb.Images{Backdrops: nil, Logos: nil, Posters: images().Posters[:1]}, nil)
+
+	New().WithDependencies(output, client).Posters(t.Context(), "550")
+
+	assert.Equal(t, "LANGUAGE  POSTER  SIZE  URL\nen        -       -     -\n", output.String())
+}
+
+func TestTMDBPostersFailure(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetMovieImages", mock.Anything, 550, mock.Anything).Return(*new(tmdb.Images), errFail)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Posters(t.Context(), "550", "de")
+	obj.Posters(t.Context(), "fight club")
+
+	assert.Equal(t, "Something went wrong.\nInvalid id: Movie ids are positive integers.\n", output.String())
+}
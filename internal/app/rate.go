@@ -0,0 +1,45 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+)
+
+// Rate rates the movie on behalf of the logged in user.
+func (a *TMDB) Rate(ctx context.Context, movieID, value string) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	movie, errID := strconv.Atoi(movieID)
+	rating, errValue := strconv.ParseFloat(value, 64)
+
+	switch {
+	case errID != nil || movie < 1:
+		err = a.oops.Code(errUnexpected).
+			With("id", movieID).
+			Public("Invalid id: Movie ids are positive integers.").
+			New("invalid id")
+	case errValue != nil:
+		err = a.oops.Code(errUnexpected).
+			With("value", value).
+			Public("Invalid rating: Values go from 0.5 to 10 in steps of 0.5.").
+			Wrap(errValue)
+	}
+
+	if err != nil {
+		return
+	}
+
+	err = oops.Wrap(a.client.RateMovie(ctx, movie, rating))
+	if err != nil {
+		return
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Rated %d with %.1f.\n", movie, rating))
+}
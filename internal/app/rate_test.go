@@ -0,0 +1,74 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+)
+
+func TestTMDBRate(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		movieID string
+		value   string
+	}
+
+	tests := []struct {
+		name string
+		want string
+		args args
+		err  error
+		call bool
+	}{
+		{
+			name: "success",
+			args: args{movieID: "550", value: "8.5"},
+			want: "Rated 550 with 8.5.\n",
+			err:  nil,
+			call: true,
+		},
+		{
+			name: "failure",
+			args: args{movieID: "550", value: "8.5"},
+			want: "Something went wrong.\n",
+			err:  errFail,
+			call: true,
+		},
+		{
+			name: "invalid id",
+			args: args{movieID: "fight", value: "8.5"},
+			want: "Invalid id: Movie ids are positive integers.\n",
+			err:  nil,
+			call: false,
+		},
+		{
+			name: "invalid value",
+			args: args{movieID: "550", value: ""},
+			want: "Invalid rating: Values go from 0.5 to 10 in steps of 0.5.\n",
+			err:  nil,
+			call: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := new(strings.Builder)
+			client := mocks.NewMockClient(t)
+
+			if test.call {
+				client.On("RateMovie", mock.Anything, 550, 8.5).Once().Return(test.err)
+			}
+
+			New().WithDependencies(output, client).Rate(t.Context(), test.args.movieID, test.args.value)
+
+			assert.Equal(t, test.want, output.String())
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const searchMulti = "multi"
+
+// Search prints a line for each result of query. Movies, TV shows and people are searched at once unless media
+// narrows it down to one of them.
+func (a *TMDB) Search(ctx context.Context, media, query string, page int) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	if page < 1 {
+		err = a.oops.Code(errUnexpected).
+			Public("Invalid page: Pages start at 1 and max at 500. They are expected to be an integer.").
+			New("page less then one")
+
+		return
+	}
+
+	found, err := a.search(ctx, media, query, page)
+	if err != nil {
+		return
+	}
+
+	if found.TotalResults == 0 {
+		fp.Silent(fmt.Fprintf(a.output, "Nothing found for %q.\n", query))
+
+		return
+	}
+
+	for _, result := range found.Results {
+		fp.Silent(fmt.Fprintf(a.output, " * %s %d: %s\n", result.MediaType, result.ID(), summary(result)))
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Page %d of %d.\n", found.Page, found.TotalPages))
+}
+
+// search runs the search of media and tags its results, so each kind reads the same as in a multi-search.
+func (a *TMDB) search(ctx context.Context, media, query string, page int) (tmdb.Page[tmdb.SearchResult], error) {
+	switch tmdb.MediaType(media) {
+	case "", searchMulti:
+		return oops.Wrap2(a.client.SearchMulti(ctx, query, page))
+	case tmdb.MediaMovie:
+		found, err := oops.Wrap2(a.client.SearchMovies(ctx, query, page))
+
+		return tagged(found, func(movie *tmdb.Movie) tmdb.SearchResult {
+			return tmdb.SearchResult{Movie: movie, TVShow: nil, Person: nil, MediaType: tmdb.MediaMovie}
+		}), err
+	case tmdb.MediaTV:
+		found, err := oops.Wrap2(a.client.SearchTV(ctx, query, page))
+
+		return tagged(found, func(show *tmdb.TVShow) tmdb.SearchResult {
+			return tmdb.SearchResult{Movie: nil, TVShow: show, Person: nil, MediaType: tmdb.MediaTV}
+		}), err
+	case tmdb.MediaPerson:
+		found, err := oops.Wrap2(a.client.SearchPeople(ctx, query, page))
+
+		return tagged(found, func(person *tmdb.Person) tmdb.SearchResult {
+			return tmdb.SearchResult{Movie: nil, TVShow: nil, Person: person, MediaType: tmdb.MediaPerson}
+		}), err
+	}
+
+	return *new(tmdb.Page[tmdb.SearchResult]), a.oops.Code(errNotFound).
+		With("media", media).
+		Public(`Unknown "-media" value for search. Allowed [multi,movie,tv,person]`).
+		New("invalid media")
+}
+
+func tagged[T any](page tmdb.Page[T], tag func(*T) tmdb.SearchResult) tmdb.Page[tmdb.SearchResult] {
+	results := make([]tmdb.SearchResult, 0, len(page.Results))
+
+	for idx := range page.Results {
+		results = append(results, tag(&page.Results[idx]))
+	}
+
+	return tmdb.Page[tmdb.SearchResult]{
+		Results:      results,
+		Page:         page.Page,
+		TotalPages:   page.TotalPages,
+		TotalResults: page.TotalResults,
+	}
+}
+
+// summary is the title and the date of a movie or a TV show, or what a person is known for.
+func summary(result tmdb.SearchResult) string {
+	switch {
+	case result.Movie != nil:
+		return fmt.Sprintf("%s (%s)", result.Movie.Title, cell(result.Movie.ReleaseDate))
+	case result.TVShow != nil:
+		return fmt.Sprintf("%s (%s)", result.TVShow.Name, cell(result.TVShow.FirstAirDate))
+	case result.Person != nil:
+		titles := make([]string, 0, len(result.Person.KnownFor))
+
+		for _, work := range result.Person.KnownFor {
+			titles = append(titles, work.Name())
+		}
+
+		return fmt.Sprintf("%s (%s), known for %s", result.Person.Name, cell(result.Person.KnownForDepartment),
+			join(titles))
+	}
+
+	return cell(result.Name())
+}
@@ -0,0 +1,106 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func results(items ...tmdb.SearchResult) tmdb.Page[tmdb.SearchResult] {
+	return tmdb.Page[tmdb.SearchResult]{Results: items, Page: 1, TotalPages: 1, TotalResults: len(items)}
+}
+
+func TestTMDBSearch(t *testing.T) {
+	t.Parallel()
+
+	movie := tracked(550, "Fight Club").Movie
+	movie.ReleaseDate = "1999-10-15"
+
+	show := new(tmdb.TVShow)
+	show.ID, show.Name, show.FirstAirDate = 1396, "Breaking Bad", "2008-01-20"
+
+	person := new(tmdb.Person)
+	person.ID, person.Name, person.KnownForDepartment = 287, "Brad Pitt", "Acting"
+	person.KnownFor = []tmdb.SearchResult{{Movie: &movie, TVShow: nil, Person: nil, MediaType: tmdb.MediaMovie}}
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("SearchMulti", mock.Anything, "fight club", 1).Return(results(
+		tmdb.SearchResult{Movie: &movie, TVShow: nil, Person: nil, MediaType: tmdb.MediaMovie},
+		tmdb.SearchResult{Movie: nil, TVShow: show, Person: nil, MediaType: tmdb.MediaTV},
+		tmdb.SearchResult{Movie: nil, TVShow: nil, Person: person, MediaType: tmdb.MediaPerson},
+		tmdb.SearchResult{Movie: nil, TVShow: nil, Person: nil, MediaType: "collection"},
+	), nil)
+	client.On("SearchMulti", mock.Anything, "nothing", 1).Return(results(), nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Search(t.Context(), "", "fight club", 1)
+	obj.Search(t.Context(), "multi", "nothing", 1)
+
+	assert.Equal(t, ` * movie 550: Fight Club (1999-10-15)
+ * tv 1396: Breaking Bad (2008-01-20)
+ * person 287: Brad Pitt (Acting), known for Fight Club
+ * collection 0: -
+Page 1 of 1.
+Nothing found for "nothing".
+`, output.String())
+}
+
+func TestTMDBSearchMedia(t *testing.T) {
+	t.Parallel()
+
+	show := new(tmdb.TVShow)
+	show.ID, show.Name = 1396, "Breaking Bad"
+
+	person := new(tmdb.Person)
+	person.ID, person.Name = 17419, "Bryan Cranston"
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("SearchMovies", mock.Anything, "fight", 1).
+		Return(tmdb.MoviesPage{Results: []tmdb.Movie{tracked(550, "Fight Club").Movie}, Page: 1, TotalPages: 1,
+			TotalResults: 1}, nil)
+	client.On("SearchTV", mock.Anything, "bad", 2).
+		Return(tmdb.Page[tmdb.TVShow]{Results: []tmdb.TVShow{*show}, Page: 2, TotalPages: 2, TotalResults: 21}, nil)
+	client.On("SearchPeople", mock.Anything, "cranston", 1).
+		Return(tmdb.Page[tmdb.Person]{Results: []tmdb.Person{*person}, Page: 1, TotalPages: 1, TotalResults: 1}, nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Search(t.Context(), "movie", "fight", 1)
+	obj.Search(t.Context(), "tv", "bad", 2)
+	obj.Search(t.Context(), "person", "cranston", 1)
+
+	assert.Equal(t, ` * movie 550: Fight Club (-)
+Page 1 of 1.
+ * tv 1396: Breaking Bad (-)
+Page 2 of 2.
+ * person 17419: Bryan Cranston (-), known for -
+Page 1 of 1.
+`, output.String())
+}
+
+func TestTMDBSearchInvalid(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("SearchPeople", mock.Anything, "cranston", 1).Return(*new(tmdb.Page[tmdb.Person]), errFail)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Search(t.Context(), "collection", "bad", 1)
+	obj.Search(t.Context(), "", "bad", 0)
+	obj.Search(t.Context(), "person", "cranston", 1)
+
+	assert.Equal(t, `Unknown "-media" value for search. Allowed [multi,movie,tv,person]
+Invalid page: Pages start at 1 and max at 500. They are expected to be an integer.
+Something went wrong.
+`, output.String())
+}
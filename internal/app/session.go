@@ -0,0 +1,114 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+)
+
+// Login walks the user through the TMDB approval page and saves the resulting session for the next runs.
+func (a *TMDB) Login(ctx context.Context) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	token, err := oops.Wrap2(a.client.CreateRequestToken(ctx))
+	if err != nil {
+		return
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Approve the access at %s and press Enter... ", token.ApprovalURL()))
+	fp.Silent(bufio.NewReader(a.input).ReadString('\n'))
+
+	session, err := oops.Wrap2(a.client.CreateSession(ctx, token.RequestToken))
+	if err != nil {
+		return
+	}
+
+	err = oops.Wrap(a.settings.SaveSession(session.SessionID))
+	if err != nil {
+		return
+	}
+
+	fp.Silent(fmt.Fprintln(a.output, "Logged in."))
+}
+
+// LoginV4 walks the user through the v4 approval page. It saves the access token the lists need together with a
+// session converted from it, so a single approval serves every command.
+func (a *TMDB) LoginV4(ctx context.Context) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	request, err := oops.Wrap2(a.client.CreateAccessRequest(ctx))
+	if err != nil {
+		return
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Approve the access at %s and press Enter... ", request.ApprovalURL()))
+	fp.Silent(bufio.NewReader(a.input).ReadString('\n'))
+
+	access, err := oops.Wrap2(a.client.CreateAccessToken(ctx, request.RequestToken))
+	if err != nil {
+		return
+	}
+
+	session, err := oops.Wrap2(a.client.CreateSessionFromV4(ctx, access.AccessToken))
+	if err != nil {
+		return
+	}
+
+	err = oops.Wrap(a.settings.SaveAccessToken(access.AccessToken))
+	if err != nil {
+		return
+	}
+
+	err = oops.Wrap(a.settings.SaveSession(session.SessionID))
+	if err != nil {
+		return
+	}
+
+	fp.Silent(fmt.Fprintln(a.output, "Logged in."))
+}
+
+// Logout deletes the saved session and access token on TMDB and locally. The local copy goes away even when TMDB
+// refuses, so an expired session does not stick.
+func (a *TMDB) Logout(ctx context.Context) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	if a.settings.SessionID == "" && a.settings.AccessToken == "" {
+		err = a.oops.Code(errNotFound).Public("You are not logged in.").New("no session")
+
+		return
+	}
+
+	var errRemote error
+
+	if a.settings.SessionID != "" {
+		errRemote = oops.Wrap(a.client.DeleteSession(ctx, a.settings.SessionID))
+	}
+
+	if a.settings.AccessToken != "" {
+		errToken := oops.Wrap(a.client.DeleteAccessToken(ctx, a.settings.AccessToken))
+		if errRemote == nil {
+			errRemote = errToken
+		}
+	}
+
+	err = oops.Wrap(a.settings.ForgetSession())
+	if err != nil {
+		return
+	}
+
+	if err = errRemote; err != nil {
+		return
+	}
+
+	fp.Silent(fmt.Fprintln(a.output, "Logged out."))
+}
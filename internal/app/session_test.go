@@ -0,0 +1,188 @@
+package app_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/internal/app"
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/internal/config"
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func withSession(t *testing.T, sessionID string) (*app.TMDB, string) {
+	t.Helper()
+
+	return withCredentials(t, sessionID, "")
+}
+
+func withCredentials(t *testing.T, sessionID, accessToken string) (*app.TMDB, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "session.env")
+
+	return fp.Must(app.New(config.Settings{
+		Token:       "secret",
+		APIKey:      "",
+		LogFormat:   "",
+		LogLevel:    "",
+		SessionID:   sessionID,
+		SessionFile: path,
+		AccessToken: accessToken,
+		CatalogFile: "",
+		WatchDir:    "",
+		Language:    "",
+		Region:      "",
+		Config: tmdb.Config{
+			Host:           "https://tmdb.host",
+			Token:          "secret",
+			Auth:           "",
+			SessionID:      "",
+			GuestSessionID: "",
+			AccessToken:    "",
+			Language:       "",
+			Region:         "",
+			Timeout:        time.Minute,
+			Logger:         nil,
+			Tracing:        nil,
+			Metrics:        nil,
+			Breaker:        nil,
+		},
+		Debug: false,
+	})), path
+}
+
+func TestTMDBLogin(t *testing.T) {
+	t.Parallel()
+
+	token := tmdb.RequestToken{RequestToken: "request-token", ExpiresAt: "", Success: true}
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("CreateRequestToken", mock.Anything).Once().Return(token, nil)
+	client.On("CreateSession", mock.Anything, "request-token").Once().
+		Return(tmdb.Session{SessionID: "session-id", Success: true}, nil)
+
+	obj, path := withSession(t, "")
+	obj.WithDependencies(strings.NewReader("\n"), output, client)
+
+	obj.Login(t.Context())
+
+	assert.Equal(t, "Approve the access at https://www.themoviedb.org/authenticate/request-token "+
+		"and press Enter... Logged in.\n", output.String())
+	assert.Contains(t, string(fp.Must(os.ReadFile(path))), `TMDB_SESSION_ID="session-id"`)
+}
+
+func TestTMDBLoginDenied(t *testing.T) {
+	t.Parallel()
+
+	token := tmdb.RequestToken{RequestToken: "request-token", ExpiresAt: "", Success: true}
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("CreateRequestToken", mock.Anything).Once().Return(token, nil)
+	client.On("CreateSession", mock.Anything, "request-token").Once().Return(*new(tmdb.Session), errFail)
+
+	obj, path := withSession(t, "")
+	obj.WithDependencies(strings.NewReader("\n"), output, client)
+
+	obj.Login(t.Context())
+
+	assert.True(t, strings.HasSuffix(output.String(), "Something went wrong.\n"))
+	assert.NoFileExists(t, path)
+}
+
+func TestTMDBLoginV4(t *testing.T) {
+	t.Parallel()
+
+	request := tmdb.AccessRequest{RequestToken: "request-token", Success: true}
+	access := tmdb.AccessToken{AccessToken: "access-token", AccountID: "account", Success: true}
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("CreateAccessRequest", mock.Anything).Once().Return(request, nil)
+	client.On("CreateAccessToken", mock.Anything, "request-token").Once().Return(access, nil)
+	client.On("CreateSessionFromV4", mock.Anything, "access-token").Once().
+		Return(tmdb.Session{SessionID: "session-id", Success: true}, nil)
+
+	obj, path := withSession(t, "")
+	obj.WithDependencies(strings.NewReader("\n"), output, client)
+
+	obj.LoginV4(t.Context())
+
+	assert.Equal(t, "Approve the access at https://www.themoviedb.org/auth/access?request_token=request-token "+
+		"and press Enter... Logged in.\n", output.String())
+
+	saved := string(fp.Must(os.ReadFile(path)))
+
+	assert.Contains(t, saved, `TMDB_ACCESS_TOKEN="access-token"`)
+	assert.Contains(t, saved, `TMDB_SESSION_ID="session-id"`)
+}
+
+func TestTMDBLogout(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("DeleteSession", mock.Anything, "session-id").Once().Return(nil)
+
+	obj, path := withSession(t, "session-id")
+	obj.WithDependencies(output, client)
+
+	require.NoError(t, os.WriteFile(path, []byte("TMDB_SESSION_ID=session-id\n"), 0o600))
+
+	obj.Logout(t.Context())
+
+	assert.Equal(t, "Logged out.\n", output.String())
+	assert.NoFileExists(t, path)
+
+	output.Reset()
+
+	obj.Logout(t.Context())
+
+	assert.Equal(t, "You are not logged in.\n", output.String())
+}
+
+func TestTMDBLogoutRejected(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("DeleteSession", mock.Anything, "session-id").Once().Return(errFail)
+
+	obj, path := withSession(t, "session-id")
+	obj.WithDependencies(output, client)
+
+	require.NoError(t, os.WriteFile(path, []byte("TMDB_SESSION_ID=session-id\n"), 0o600))
+
+	obj.Logout(t.Context())
+
+	assert.Equal(t, "Something went wrong.\n", output.String())
+	assert.NoFileExists(t, path)
+}
+
+func TestTMDBLogoutAccessToken(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("DeleteSession", mock.Anything, "session-id").Once().Return(nil)
+	client.On("DeleteAccessToken", mock.Anything, "access-token").Once().Return(errFail)
+
+	obj, path := withCredentials(t, "session-id", "access-token")
+	obj.WithDependencies(output, client)
+
+	saved := "TMDB_SESSION_ID=session-id\nTMDB_ACCESS_TOKEN=access-token\n"
+
+	require.NoError(t, os.WriteFile(path, []byte(saved), 0o600))
+
+	obj.Logout(t.Context())
+
+	assert.Equal(t, "Something went wrong.\n", output.String())
+	assert.NoFileExists(t, path)
+}
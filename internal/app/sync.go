@@ -0,0 +1,223 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/internal/catalog"
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const syncedLayout = "2006-01-02 15:04"
+
+// Sync keeps the local catalog up to date. add|rm <id> select the movies and ls shows them. Without an action it
+// polls the changes since the last sync and fetches again only the movies that changed, all of them the first time.
+func (a *TMDB) Sync(ctx context.Context, action, operand string) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	path, err := oops.Wrap2(a.settings.CatalogPath())
+	if err != nil {
+		return
+	}
+
+	store, err := oops.Wrap2(catalog.Open(path))
+	if err != nil {
+		return
+	}
+
+	switch action {
+	case "":
+		err = a.sync(ctx, store)
+	case actionAdd:
+		err = a.track(ctx, store, operand)
+	case actionRemove:
+		err = a.untrack(store, operand)
+	case actionList:
+		a.catalog(store)
+	default:
+		err = a.oops.Code(errNotFound).
+			With("action", action).
+			Public("Unknown sync action. Allowed [add,rm,ls]").
+			New("invalid action")
+	}
+}
+
+// sync keeps what it fetched even when it fails midway, the reported error being the fetch one. The sync time only
+// moves on once every change is in.
+func (a *TMDB) sync(ctx context.Context, store *catalog.Catalog) error {
+	started := a.clock()
+	stale := store.IDs()
+
+	if !store.Synced.IsZero() && len(stale) > 0 {
+		var err error
+
+		stale, err = a.changed(ctx, store.Synced, started, stale)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, movieID := range stale {
+		details, err := oops.Wrap2(a.client.GetMovieDetails(ctx, movieID, tmdb.DetailsOptions{Append: nil}))
+		if err != nil {
+			_ = store.Save()
+
+			return err
+		}
+
+		store.Movies[movieID] = details
+
+		fp.Silent(fmt.Fprintf(a.output, "Updated %d %q.\n", movieID, details.Title))
+	}
+
+	store.Synced = started
+
+	err := oops.Wrap(store.Save())
+	if err != nil {
+		return err
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Synced %d movies, %d updated.\n", len(store.Movies), len(stale)))
+
+	return nil
+}
+
+// changed returns the tracked movies changed from since up to until, walking the feed window by window.
+func (a *TMDB) changed(ctx context.Context, since, until time.Time, tracked []int) ([]int, error) {
+	changed := make([]int, 0)
+
+	for start := since; start.Before(until); start = start.Add(tmdb.ChangesWindow) {
+		end := start.Add(tmdb.ChangesWindow)
+		if end.After(until) {
+			end = until
+		}
+
+		ids, err := a.changedIn(ctx, tmdb.ChangesOptions{Start: start, End: end, Page: 1}, tracked)
+		if err != nil {
+			return nil, err
+		}
+
+		changed = append(changed, ids...)
+	}
+
+	slices.Sort(changed)
+
+	return slices.Compact(changed), nil
+}
+
+// changedIn pages through the changes feed of one window. When the feed has more pages than there are tracked
+// movies, asking about each movie is cheaper.
+func (a *TMDB) changedIn(ctx context.Context, opts tmdb.ChangesOptions, tracked []int) ([]int, error) {
+	changed := make([]int, 0)
+
+	feed, err := oops.Wrap2(a.client.GetChangedMovies(ctx, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	if feed.TotalPages > len(tracked) {
+		for _, movieID := range tracked {
+			changes, err := oops.Wrap2(a.client.GetMovieChanges(ctx, movieID, opts))
+			if err != nil {
+				return nil, err
+			}
+
+			if len(changes) > 0 {
+				changed = append(changed, movieID)
+			}
+		}
+
+		return changed, nil
+	}
+
+	for {
+		for _, item := range feed.Results {
+			if slices.Contains(tracked, item.ID) {
+				changed = append(changed, item.ID)
+			}
+		}
+
+		if opts.Page >= feed.TotalPages {
+			return changed, nil
+		}
+
+		opts.Page++
+
+		feed, err = oops.Wrap2(a.client.GetChangedMovies(ctx, opts))
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (a *TMDB) track(ctx context.Context, store *catalog.Catalog, operand string) error {
+	movieID, err := a.parseID("Movie", operand)
+	if err != nil {
+		return err
+	}
+
+	details, err := oops.Wrap2(a.client.GetMovieDetails(ctx, movieID, tmdb.DetailsOptions{Append: nil}))
+	if err != nil {
+		return err
+	}
+
+	store.Movies[movieID] = details
+
+	err = oops.Wrap(store.Save())
+	if err != nil {
+		return err
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Added %d %q to the catalog.\n", movieID, details.Title))
+
+	return nil
+}
+
+func (a *TMDB) untrack(store *catalog.Catalog, operand string) error {
+	movieID, err := a.parseID("Movie", operand)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := store.Movies[movieID]; !ok {
+		return a.oops.Code(errNotFound).With("id", movieID).Public("Not in the catalog.").New("not tracked")
+	}
+
+	delete(store.Movies, movieID)
+
+	err = oops.Wrap(store.Save())
+	if err != nil {
+		return err
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, "Removed %d from the catalog.\n", movieID))
+
+	return nil
+}
+
+func (a *TMDB) catalog(store *catalog.Catalog) {
+	if len(store.Movies) == 0 {
+		fp.Silent(fmt.Fprintln(a.output, "Nothing in the catalog yet."))
+
+		return
+	}
+
+	for _, movieID := range store.IDs() {
+		a.print(store.Movies[movieID].Movie)
+	}
+
+	if store.Synced.IsZero() {
+		fp.Silent(fmt.Fprintf(a.output, "%d movies, never synced.\n", len(store.Movies)))
+	} else {
+		synced := store.Synced.Local().Format(syncedLayout)
+
+		fp.Silent(fmt.Fprintf(a.output, "%d movies, synced %s.\n", len(store.Movies), synced))
+	}
+}
@@ -0,0 +1,205 @@
+package app_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/internal/app"
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/internal/catalog"
+	"github.com/therenotomorrow/tmdb/internal/config"
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func syncTime() time.Time {
+	return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+}
+
+func withCatalog(t *testing.T, synced time.Time, movieIDs ...int) (*app.TMDB, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "catalog.json")
+	store := fp.Must(catalog.Open(path))
+	store.Synced = synced
+
+	for _, movieID := range movieIDs {
+		store.Movies[movieID] = tracked(movieID, "stale")
+	}
+
+	require.NoError(t, store.Save())
+
+	return fp.Must(app.New(config.Settings{
+		Token:       "secret",
+		APIKey:      "",
+		LogFormat:   "",
+		LogLevel:    "",
+		SessionID:   "",
+		SessionFile: "",
+		AccessToken: "",
+		CatalogFile: path,
+		WatchDir:    "",
+		Language:    "",
+		Region:      "",
+		Config: tmdb.Config{
+			Host:           "https://tmdb.host",
+			Token:          "secret",
+			Auth:           "",
+			SessionID:      "",
+			GuestSessionID: "",
+			AccessToken:    "",
+			Language:       "",
+			Region:         "",
+			Timeout:        time.Minute,
+			Logger:         nil,
+			Tracing:        nil,
+			Metrics:        nil,
+			Breaker:        nil,
+		},
+		Debug: false,
+	})).WithDependencies(syncTime), path
+}
+
+func tracked(movieID int, title string) tmdb.MovieDetails {
+	data := new(tmdb.MovieDetails)
+	data.ID = movieID
+	data.Title = title
+
+	return *data
+}
+
+func window(start time.Time, page int) func(opts tmdb.ChangesOptions) bool {
+	return func(opts tmdb.ChangesOptions) bool {
+		return opts.Start.Equal(start) && opts.Page == page
+	}
+}
+
+func changedPage(pages int, ids ...int) tmdb.Page[tmdb.ChangedItem] {
+	items := make([]tmdb.ChangedItem, 0, len(ids))
+
+	for _, id := range ids {
+		items = append(items, tmdb.ChangedItem{ID: id, Adult: false})
+	}
+
+	return tmdb.Page[tmdb.ChangedItem]{Results: items, Page: 1, TotalPages: pages, TotalResults: len(ids)}
+}
+
+func TestTMDBSyncSelection(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetMovieDetails", mock.Anything, 550, tmdb.DetailsOptions{Append: nil}).Once().
+		Return(tracked(550, "Fight Club"), nil)
+
+	obj, path := withCatalog(t, time.Time{})
+	obj.WithDependencies(output, client)
+
+	obj.Sync(t.Context(), "ls", "")
+	obj.Sync(t.Context(), "add", "550")
+	obj.Sync(t.Context(), "ls", "")
+
+	assert.Equal(t, []int{550}, fp.Must(catalog.Open(path)).IDs())
+
+	obj.Sync(t.Context(), "rm", "550")
+	obj.Sync(t.Context(), "rm", "550")
+	obj.Sync(t.Context(), "pull", "")
+
+	got := output.String()
+
+	assert.True(t, strings.HasPrefix(got, "Nothing in the catalog yet.\nAdded 550 \"Fight Club\" to the catalog.\n"))
+	assert.Contains(t, got, `---- "Fight Club" ----`)
+	assert.True(t, strings.HasSuffix(got, "1 movies, never synced.\nRemoved 550 from the catalog.\n"+
+		"Not in the catalog.\nUnknown sync action. Allowed [add,rm,ls]\n"))
+	assert.Empty(t, fp.Must(catalog.Open(path)).Movies)
+}
+
+func TestTMDBSyncFirstRun(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetMovieDetails", mock.Anything, 13, mock.Anything).Once().Return(tracked(13, "Forrest Gump"), nil)
+	client.On("GetMovieDetails", mock.Anything, 550, mock.Anything).Once().Return(tracked(550, "Fight Club"), nil)
+
+	obj, path := withCatalog(t, time.Time{}, 550, 13)
+	obj.WithDependencies(output, client)
+
+	obj.Sync(t.Context(), "", "")
+
+	assert.Equal(t, "Updated 13 \"Forrest Gump\".\nUpdated 550 \"Fight Club\".\nSynced 2 movies, 2 updated.\n",
+		output.String())
+
+	store := fp.Must(catalog.Open(path))
+
+	assert.True(t, syncTime().Equal(store.Synced))
+	assert.Equal(t, "Fight Club", store.Movies[550].Title)
+}
+
+func TestTMDBSyncChanges(t *testing.T) {
+	t.Parallel()
+
+	since := syncTime().AddDate(0, 0, -20)
+	second := since.Add(tmdb.ChangesWindow)
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetChangedMovies", mock.Anything, mock.MatchedBy(window(since, 1))).Once().
+		Return(changedPage(2, 550, 999), nil)
+	client.On("GetChangedMovies", mock.Anything, mock.MatchedBy(window(since, 2))).Once().Return(changedPage(2, 1), nil)
+	client.On("GetChangedMovies", mock.Anything, mock.MatchedBy(window(second, 1))).Once().
+		Return(changedPage(5, 2), nil)
+	client.On("GetMovieChanges", mock.Anything, 13, mock.MatchedBy(window(second, 1))).Once().
+		Return([]tmdb.Change{}, nil)
+	client.On("GetMovieChanges", mock.Anything, 550, mock.MatchedBy(window(second, 1))).Once().
+		Return([]tmdb.Change{}, nil)
+	client.On("GetMovieChanges", mock.Anything, 680, mock.MatchedBy(window(second, 1))).Once().
+		Return([]tmdb.Change{{Key: "title", Items: nil}}, nil)
+	client.On("GetMovieDetails", mock.Anything, 550, mock.Anything).Once().Return(tracked(550, "Fight Club"), nil)
+	client.On("GetMovieDetails", mock.Anything, 680, mock.Anything).Once().Return(tracked(680, "Pulp Fiction"), nil)
+
+	obj, path := withCatalog(t, since, 550, 13, 680)
+	obj.WithDependencies(output, client)
+
+	obj.Sync(t.Context(), "", "")
+
+	assert.Equal(t, "Updated 550 \"Fight Club\".\nUpdated 680 \"Pulp Fiction\".\nSynced 3 movies, 2 updated.\n",
+		output.String())
+
+	store := fp.Must(catalog.Open(path))
+
+	assert.True(t, syncTime().Equal(store.Synced))
+	assert.Equal(t, "stale", store.Movies[13].Title)
+}
+
+func TestTMDBSyncFailure(t *testing.T) {
+	t.Parallel()
+
+	since := syncTime().AddDate(0, 0, -1)
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetChangedMovies", mock.Anything, mock.MatchedBy(window(since, 1))).Once().
+		Return(changedPage(1, 13, 550), nil)
+	client.On("GetMovieDetails", mock.Anything, 13, mock.Anything).Once().Return(tracked(13, "Forrest Gump"), nil)
+	client.On("GetMovieDetails", mock.Anything, 550, mock.Anything).Once().Return(*new(tmdb.MovieDetails), errFail)
+
+	obj, path := withCatalog(t, since, 550, 13)
+	obj.WithDependencies(output, client)
+
+	obj.Sync(t.Context(), "", "")
+
+	assert.Equal(t, "Updated 13 \"Forrest Gump\".\nSomething went wrong.\n", output.String())
+
+	store := fp.Must(catalog.Open(path))
+
+	assert.True(t, since.Equal(store.Synced))
+	assert.Equal(t, "Forrest Gump", store.Movies[13].Title)
+	assert.Equal(t, "stale", store.Movies[550].Title)
+}
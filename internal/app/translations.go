@@ -0,0 +1,90 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	translationsHeader = "---- Translations ----\nLANGUAGE\tTITLE\tLOCAL TITLES\tOVERVIEW\n"
+
+	tableMinWidth = 0
+	tableTabWidth = 4
+	tablePadding  = 2
+)
+
+// translations lines up the title, the local titles and the overview of every translation matching languages. A
+// language without one gets a row of dashes, so a missing translation stands out.
+func (a *TMDB) translations(details tmdb.MovieDetails, languages []string) {
+	var (
+		translations []tmdb.Translation
+		titles       []tmdb.AltTitle
+	)
+
+	if details.Translations != nil {
+		translations = details.Translations.Translations
+	}
+
+	if details.AltTitles != nil {
+		titles = details.AltTitles.Titles
+	}
+
+	table := tabwriter.NewWriter(a.output, tableMinWidth, tableTabWidth, tablePadding, ' ', 0)
+
+	fp.Silent(fmt.Fprint(table, translationsHeader))
+
+	for _, language := range languages {
+		found := false
+
+		for _, translation := range translations {
+			if !translation.Matches(language) {
+				continue
+			}
+
+			found = true
+
+			fp.Silent(fmt.Fprintf(table, "%s\t%s\t%s\t%s\n",
+				translation.Tag(),
+				cell(translation.Data.Title),
+				localTitles(titles, translation.Country),
+				cell(translation.Data.Overview),
+			))
+		}
+
+		if !found {
+			fp.Silent(fmt.Fprintf(table, "%s\t-\t-\t-\n", language))
+		}
+	}
+
+	_ = table.Flush()
+}
+
+func localTitles(titles []tmdb.AltTitle, country string) string {
+	names := make([]string, 0)
+
+	for _, title := range titles {
+		switch {
+		case title.Country != country:
+			continue
+		case title.Type != "":
+			names = append(names, cell(title.Title)+" ("+title.Type+")")
+		default:
+			names = append(names, cell(title.Title))
+		}
+	}
+
+	return join(names)
+}
+
+// cell keeps a value on one line of the table.
+func cell(value string) string {
+	if value = strings.Join(strings.Fields(value), " "); value == "" {
+		return "-"
+	}
+
+	return value
+}
@@ -0,0 +1,61 @@
+package app_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func translated(language, country, title, overview string) tmdb.Translation {
+	translation := new(tmdb.Translation)
+	translation.Language = language
+	translation.Country = country
+	translation.Data.Title = title
+	translation.Data.Overview = overview
+
+	return *translation
+}
+
+func TestTMDBDetailsTranslations(t *testing.T) {
+	t.Parallel()
+
+	want := `---- Translations ----
+LANGUAGE  TITLE                LOCAL TITLES                                          OVERVIEW
+es-ES     El club de la lucha  El club de la lucha                                   Un joven sin ilusiones.
+es-MX     El club de la pelea  El club de la pelea, Club de pelea (alternate title)  Un empleado insomne.
+de-DE     -                    -                                                     Ein Yuppie findet Gefallen.
+pt-BR     -                    -                                                     -
+`
+
+	movie := details()
+	movie.AltTitles = &tmdb.AltTitles{Titles: []tmdb.AltTitle{
+		{Country: "ES", Title: "El club de la lucha", Type: ""},
+		{Country: "MX", Title: "El club de la pelea", Type: ""},
+		{Country: "MX", Title: "Club de pelea", Type: "alternate title"},
+	}}
+	movie.Translations = &tmdb.Translations{Translations: []tmdb.Translation{
+		translated("de", "DE", "", "Ein Yuppie\nfindet Gefallen."),
+		translated("es", "ES", "El club de la lucha", "Un joven sin ilusiones."),
+		translated("es", "MX", "El club de la pelea", "Un empleado insomne."),
+	}}
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetMovieDetails", mock.Anything, 550, mock.MatchedBy(func(opts tmdb.DetailsOptions) bool {
+		return slices.Contains(opts.Append, tmdb.AppendAltTitles) &&
+			slices.Contains(opts.Append, tmdb.AppendTranslations)
+	})).Return(movie, nil)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Details(t.Context(), 550, "es", "de-DE", "pt-BR")
+
+	assert.True(t, strings.HasPrefix(output.String(), `---- "Fight Club" (1999-10-15) ----`))
+	assert.True(t, strings.HasSuffix(output.String(), " > overview\n"+want))
+}
@@ -0,0 +1,213 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	showTemplate = `---- %q (%s) ----
+ * Status: %s
+ * Seasons: %d (%d episodes)
+ * Runtime: %s
+ * Genres: %s
+ * Networks: %s
+ * Votes: %d (%.1f)
+ * Cast: %s
+ > %s
+`
+	seasonsHeader = "---- Seasons ----\nSEASON\tNAME\tAIR DATE\tEPISODES\n"
+
+	seasonTemplate = `---- %q (%s) ----
+ > %s
+`
+	episodesHeader = "EPISODE\tNAME\tAIR DATE\tRUNTIME\n"
+
+	episodeTemplate = `---- %s %q (%s) ----
+ * Runtime: %s
+ * Votes: %d (%.1f)
+ * Director: %s
+ * Guest stars: %s
+ > %s
+`
+)
+
+// Show shows a TV show with its main cast over all the seasons and a row for each season.
+func (a *TMDB) Show(ctx context.Context, operand string) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	seriesID, err := a.parseID("Show", operand)
+	if err != nil {
+		return
+	}
+
+	details, err := oops.Wrap2(a.client.GetTVShowDetails(ctx, seriesID))
+	if err != nil {
+		return
+	}
+
+	credits, err := oops.Wrap2(a.client.GetAggregateCredits(ctx, seriesID))
+	if err != nil {
+		return
+	}
+
+	fp.Silent(fmt.Fprintf(
+		a.output,
+		showTemplate,
+		details.Name,
+		details.FirstAirDate,
+		cell(details.Status),
+		details.NumberOfSeasons,
+		details.NumberOfEpisodes,
+		runtimes(details.EpisodeRunTime),
+		genres(details.Genres),
+		networks(details.Networks),
+		details.VoteCount,
+		details.VoteAverage,
+		aggregateCast(credits),
+		details.Overview,
+	))
+
+	table := tabwriter.NewWriter(a.output, tableMinWidth, tableTabWidth, tablePadding, ' ', 0)
+
+	fp.Silent(fmt.Fprint(table, seasonsHeader))
+
+	for _, season := range details.Seasons {
+		fp.Silent(fmt.Fprintf(table, "%d\t%s\t%s\t%d\n",
+			season.SeasonNumber, cell(season.Name), cell(season.AirDate), season.EpisodeCount))
+	}
+
+	_ = table.Flush()
+}
+
+// Season lists the episodes of a season, season 0 being the specials. With an episode operand it shows that
+// episode instead.
+func (a *TMDB) Season(ctx context.Context, show, season, episode string) {
+	var err error
+
+	defer func() { a.report(err) }()
+
+	seriesID, err := a.parseID("Show", show)
+	if err != nil {
+		return
+	}
+
+	number, err := strconv.Atoi(season)
+	if err != nil || number < 0 {
+		err = a.oops.Code(errUnexpected).
+			With("season", season).
+			Public("Invalid season: Seasons are numbered from 0, the specials.").
+			New("invalid season")
+
+		return
+	}
+
+	if episode != "" {
+		err = a.episode(ctx, seriesID, number, episode)
+
+		return
+	}
+
+	details, err := oops.Wrap2(a.client.GetTVSeason(ctx, seriesID, number))
+	if err != nil {
+		return
+	}
+
+	fp.Silent(fmt.Fprintf(a.output, seasonTemplate, details.Name, cell(details.AirDate), details.Overview))
+
+	table := tabwriter.NewWriter(a.output, tableMinWidth, tableTabWidth, tablePadding, ' ', 0)
+
+	fp.Silent(fmt.Fprint(table, episodesHeader))
+
+	for _, item := range details.Episodes {
+		fp.Silent(fmt.Fprintf(table, "%s\t%s\t%s\t%s\n",
+			code(item), cell(item.Name), cell(item.AirDate), runningTime(item.Runtime)))
+	}
+
+	_ = table.Flush()
+}
+
+func (a *TMDB) episode(ctx context.Context, seriesID, season int, operand string) error {
+	number, err := strconv.Atoi(operand)
+	if err != nil || number < 1 {
+		return a.oops.Code(errUnexpected).
+			With("episode", operand).
+			Public("Invalid episode: Episodes are numbered from 1.").
+			New("invalid episode")
+	}
+
+	episode, err := oops.Wrap2(a.client.GetTVEpisode(ctx, seriesID, season, number))
+	if err != nil {
+		return err
+	}
+
+	fp.Silent(fmt.Fprintf(
+		a.output,
+		episodeTemplate,
+		code(episode),
+		episode.Name,
+		cell(episode.AirDate),
+		runningTime(episode.Runtime),
+		episode.VoteCount,
+		episode.VoteAverage,
+		director(&tmdb.Credits{Cast: nil, Crew: episode.Crew}),
+		cast(&tmdb.Credits{Cast: episode.GuestStars, Crew: nil}),
+		episode.Overview,
+	))
+
+	return nil
+}
+
+// code is the usual short name of an episode, e.g. S01E05.
+func code(episode tmdb.Episode) string {
+	return fmt.Sprintf("S%02dE%02d", episode.SeasonNumber, episode.EpisodeNumber)
+}
+
+// runningTime is empty until TMDB knows it.
+func runningTime(minutes int) string {
+	if minutes < 1 {
+		return "-"
+	}
+
+	return strconv.Itoa(minutes) + " min"
+}
+
+func runtimes(items []int) string {
+	names := make([]string, 0, len(items))
+
+	for _, minutes := range items {
+		names = append(names, runningTime(minutes))
+	}
+
+	return join(names)
+}
+
+func networks(items []tmdb.Network) string {
+	names := make([]string, 0, len(items))
+
+	for _, network := range items {
+		names = append(names, network.Name)
+	}
+
+	return join(names)
+}
+
+// aggregateCast names the cast with the number of episodes each person is in.
+func aggregateCast(credits tmdb.AggregateCredits) string {
+	names := make([]string, 0, len(credits.Cast))
+
+	for _, person := range credits.Cast {
+		names = append(names, fmt.Sprintf("%s (%d)", person.Name, person.TotalEpisodeCount))
+	}
+
+	return join(names)
+}
@@ -0,0 +1,202 @@
+package app_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func show() tmdb.TVShowDetails {
+	details := new(tmdb.TVShowDetails)
+
+	details.ID = 1396
+	details.Name = "Breaking Bad"
+	details.FirstAirDate = "2008-01-20"
+	details.Overview = "overview"
+	details.Status = "Ended"
+	details.NumberOfSeasons = 5
+	details.NumberOfEpisodes = 62
+	details.EpisodeRunTime = []int{45, 47}
+	details.VoteCount = 15000
+	details.VoteAverage = 8.9
+	details.Genres = []tmdb.Genre{{Name: "Drama", ID: 18}}
+	details.Networks = []tmdb.Network{{Name: "AMC", Country: "US", ID: 174}}
+	details.Seasons = []tmdb.SeasonSummary{
+		{Name: "Specials", Overview: "", AirDate: "", ID: 3577, SeasonNumber: 0, EpisodeCount: 9},
+		{Name: "Season 1", Overview: "", AirDate: "2008-01-20", ID: 3572, SeasonNumber: 1, EpisodeCount: 7},
+	}
+
+	return *details
+}
+
+func episode(number int, name, airDate string, runtime int) tmdb.Episode {
+	item := new(tmdb.Episode)
+
+	item.SeasonNumber = 1
+	item.EpisodeNumber = number
+	item.Name = name
+	item.AirDate = airDate
+	item.Runtime = runtime
+
+	return *item
+}
+
+func TestTMDBShow(t *testing.T) {
+	t.Parallel()
+
+	want := `---- "Breaking Bad" (2008-01-20) ----
+ * Status: Ended
+ * Seasons: 5 (62 episodes)
+ * Runtime: 45 min, 47 min
+ * Genres: Drama
+ * Networks: AMC
+ * Votes: 15000 (8.9)
+ * Cast: Bryan Cranston (62), Aaron Paul (62)
+ > overview
+---- Seasons ----
+SEASON  NAME      AIR DATE    EPISODES
+0       Specials  -           9
+1       Season 1  2008-01-20  7
+`
+
+	credits := tmdb.AggregateCredits{
+		Cast: []tmdb.AggregateCast{
+			{Name: "Bryan Cranston", Roles: nil, ID: 17419, Order: 0, TotalEpisodeCount: 62},
+			{Name: "Aaron Paul", Roles: nil, ID: 84497, Order: 1, TotalEpisodeCount: 62},
+		},
+		Crew: nil,
+	}
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetTVShowDetails", mock.Anything, 1396).Return(show(), nil)
+	client.On("GetAggregateCredits", mock.Anything, 1396).Return(credits, nil)
+
+	New().WithDependencies(output, client).Show(t.Context(), "1396")
+
+	assert.Equal(t, want, output.String())
+}
+
+func TestTMDBShowFailure(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetTVShowDetails", mock.Anything, 1396).Return(show(), nil)
+	client.On("GetAggregateCredits", mock.Anything, 1396).Return(*new(tmdb.AggregateCredits), errFail)
+
+	obj := New().WithDependencies(output, client)
+
+	obj.Show(t.Context(), "1396")
+	obj.Show(t.Context(), "bad")
+
+	assert.Equal(t, "Something went wrong.\nInvalid id: Show ids are positive integers.\n", output.String())
+}
+
+func TestTMDBSeason(t *testing.T) {
+	t.Parallel()
+
+	want := `---- "Season 1" (2008-01-20) ----
+ > overview
+EPISODE  NAME                 AIR DATE    RUNTIME
+S01E01   Pilot                2008-01-20  58 min
+S01E02   Cat's in the Bag...  2008-01-27  48 min
+S01E03   TBA                  -           -
+`
+
+	season := new(tmdb.Season)
+	season.Name = "Season 1"
+	season.AirDate = "2008-01-20"
+	season.Overview = "overview"
+	season.SeasonNumber = 1
+	season.Episodes = []tmdb.Episode{
+		episode(1, "Pilot", "2008-01-20", 58),
+		episode(2, "Cat's in the Bag...", "2008-01-27", 48),
+		episode(3, "TBA", "", 0),
+	}
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetTVSeason", mock.Anything, 1396, 1).Return(*season, nil)
+
+	New().WithDependencies(output, client).Season(t.Context(), "1396", "1", "")
+
+	assert.Equal(t, want, output.String())
+}
+
+func TestTMDBSeasonEpisode(t *testing.T) {
+	t.Parallel()
+
+	want := `---- S01E01 "Pilot" (2008-01-20) ----
+ * Runtime: 58 min
+ * Votes: 120 (8.3)
+ * Director: Vince Gilligan
+ * Guest stars: Steven Michael Quezada
+ > overview
+`
+
+	pilot := episode(1, "Pilot", "2008-01-20", 58)
+	pilot.Overview = "overview"
+	pilot.VoteCount = 120
+	pilot.VoteAverage = 8.3
+	pilot.Crew = []tmdb.Crew{{Name: "Vince Gilligan", Job: "Director", Department: "Directing", ID: 66633}}
+	pilot.GuestStars = []tmdb.Cast{cast("Steven Michael Quezada")}
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetTVEpisode", mock.Anything, 1396, 1, 1).Return(pilot, nil)
+
+	New().WithDependencies(output, client).Season(t.Context(), "1396", "1", "1")
+
+	assert.Equal(t, want, output.String())
+}
+
+func TestTMDBSeasonInvalid(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		show    string
+		season  string
+		episode string
+	}
+
+	tests := []struct {
+		name string
+		want string
+		args args
+	}{
+		{
+			name: "show",
+			args: args{show: "0", season: "1", episode: ""},
+			want: "Invalid id: Show ids are positive integers.\n",
+		},
+		{
+			name: "season",
+			args: args{show: "1396", season: "-1", episode: ""},
+			want: "Invalid season: Seasons are numbered from 0, the specials.\n",
+		},
+		{
+			name: "episode",
+			args: args{show: "1396", season: "1", episode: "first"},
+			want: "Invalid episode: Episodes are numbered from 1.\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := new(strings.Builder)
+
+			New().WithDependencies(output, mocks.NewMockClient(t)).
+				Season(t.Context(), test.args.show, test.args.season, test.args.episode)
+
+			assert.Equal(t, test.want, output.String())
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/internal/snapshot"
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	// watchPages is enough for the lists to be read whole, now playing rarely goes past a hundred movies.
+	watchPages       = "1-5"
+	watchMinInterval = time.Minute
+)
+
+// watched is the list Watch polls and what it held at the previous poll.
+type watched struct {
+	fetcher  FetchFunc
+	previous snapshot.Snapshot
+	kind     string
+	dir      string
+	first    int
+	last     int
+}
+
+// Watch polls the kind list every interval and prints the titles that appeared on it or dropped off since the
+// previous poll, the last one of an earlier run included. A failed poll is reported and retried at the next tick.
+// It runs until ctx is done.
+func (a *TMDB) Watch(ctx context.Context, kind, pages string, interval time.Duration) {
+	list, err := a.watching(kind, pages, interval)
+	if err != nil {
+		a.report(err)
+
+		return
+	}
+
+	for {
+		err = a.poll(ctx, list)
+		if ctx.Err() == nil {
+			a.report(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			fp.Silent(fmt.Fprintln(a.output, "Stopped watching."))
+
+			return
+		case <-a.after(interval):
+		}
+	}
+}
+
+// watching checks the arguments of Watch and opens the state of the previous polls.
+func (a *TMDB) watching(kind, pages string, interval time.Duration) (*watched, error) {
+	fetcher := a.source(kind)
+	if fetcher == nil {
+		return nil, a.oops.Code(errNotFound).
+			Public(`Unknown "-type" value for watch. Allowed [playing,popular,top,upcoming,latest]`).
+			New("invalid type")
+	}
+
+	if interval < watchMinInterval {
+		return nil, a.oops.Code(errUnexpected).
+			With("interval", interval).
+			Public("Invalid interval: Lists are polled at most once a minute.").
+			New("interval too short")
+	}
+
+	if pages == "" {
+		pages = watchPages
+	}
+
+	first, last, err := a.parsePages(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := oops.Wrap2(a.settings.WatchPath())
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := oops.Wrap2(snapshot.Open(dir, kind))
+	if err != nil {
+		return nil, err
+	}
+
+	return &watched{fetcher: fetcher, previous: previous, kind: kind, dir: dir, first: first, last: last}, nil
+}
+
+// source is the list Select picks, or the latest movie alone.
+func (a *TMDB) source(kind string) FetchFunc {
+	if fetchType(kind) == fetchTypeLatest {
+		return a.latest
+	}
+
+	return a.Select(kind)
+}
+
+func (a *TMDB) latest(ctx context.Context, page int) ([]tmdb.Movie, error) {
+	if page > 1 {
+		return nil, nil
+	}
+
+	details, err := a.client.GetLatestMovie(ctx)
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+
+	return []tmdb.Movie{details.Movie}, nil
+}
+
+// poll keeps the new snapshot only when every page came, a missing page would read as titles dropping off.
+func (a *TMDB) poll(ctx context.Context, list *watched) error {
+	next := snapshot.Snapshot{Movies: make(map[int]string), Polled: a.clock()}
+
+	for _, result := range tmdb.FetchPages(ctx, tmdb.PageFunc(list.fetcher), list.first, list.last, concurrency) {
+		if result.Err != nil {
+			return oops.Wrap(result.Err)
+		}
+
+		for _, movie := range result.Movies {
+			next.Movies[movie.ID] = movie.Title
+		}
+	}
+
+	err := oops.Wrap(next.Save(list.dir, list.kind))
+	if err != nil {
+		return err
+	}
+
+	previous := list.previous
+	list.previous = next
+
+	stamp := next.Polled.Format(syncedLayout)
+
+	if previous.Polled.IsZero() {
+		fp.Silent(fmt.Fprintf(a.output, "%s watching %d %q movies.\n", stamp, len(next.Movies), list.kind))
+
+		return nil
+	}
+
+	added, dropped := previous.Diff(next)
+
+	for _, movieID := range added {
+		fp.Silent(fmt.Fprintf(a.output, "%s + %q\n", stamp, next.Movies[movieID]))
+	}
+
+	// the latest movie is replaced, not dropped
+	if fetchType(list.kind) == fetchTypeLatest {
+		return nil
+	}
+
+	for _, movieID := range dropped {
+		fp.Silent(fmt.Fprintf(a.output, "%s - %q\n", stamp, previous.Movies[movieID]))
+	}
+
+	return nil
+}
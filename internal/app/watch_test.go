@@ -0,0 +1,219 @@
+package app_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/therenotomorrow/tmdb/internal/app"
+	"github.com/therenotomorrow/tmdb/internal/app/mocks"
+	"github.com/therenotomorrow/tmdb/internal/config"
+	"github.com/therenotomorrow/tmdb/internal/snapshot"
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func withWatch(t *testing.T, dir string) *app.TMDB {
+	t.Helper()
+
+	return fp.Must(app.New(config.Settings{
+		Token:       "secret",
+		APIKey:      "",
+		LogFormat:   "",
+		LogLevel:    "",
+		SessionID:   "",
+		SessionFile: "",
+		AccessToken: "",
+		CatalogFile: "",
+		WatchDir:    dir,
+		Language:    "",
+		Region:      "",
+		Config: tmdb.Config{
+			Host:           "https://tmdb.host",
+			Token:          "secret",
+			Auth:           "",
+			SessionID:      "",
+			GuestSessionID: "",
+			AccessToken:    "",
+			Language:       "",
+			Region:         "",
+			Timeout:        time.Minute,
+			Logger:         nil,
+			Tracing:        nil,
+			Metrics:        nil,
+			Breaker:        nil,
+		},
+		Debug: false,
+	})).WithDependencies(syncTime)
+}
+
+// ticks lets Watch poll the given number of times, then stops it.
+func ticks(polls int, cancel context.CancelFunc) func(time.Duration) <-chan time.Time {
+	return func(time.Duration) <-chan time.Time {
+		polls--
+		if polls == 0 {
+			cancel()
+
+			return nil
+		}
+
+		tick := make(chan time.Time, 1)
+		tick <- syncTime()
+
+		return tick
+	}
+}
+
+func moviesOf(movies ...tmdb.Movie) []tmdb.Movie {
+	return movies
+}
+
+func TestTMDBWatch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fightClub := tracked(550, "Fight Club").Movie
+	forrestGump := tracked(13, "Forrest Gump").Movie
+	pulpFiction := tracked(680, "Pulp Fiction").Movie
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetNowPlayingMovies", mock.Anything, 1).Once().Return(moviesOf(fightClub, forrestGump), nil)
+	client.On("GetNowPlayingMovies", mock.Anything, 1).Once().Return(moviesOf(fightClub, pulpFiction), nil)
+	client.On("GetNowPlayingMovies", mock.Anything, 1).Once().Return(moviesOf(pulpFiction), nil)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	withWatch(t, dir).WithDependencies(output, client, ticks(2, cancel)).Watch(ctx, "playing", "1", time.Hour)
+
+	assert.Equal(t, `2026-10-19 12:00 watching 2 "playing" movies.
+2026-10-19 12:00 + "Pulp Fiction"
+2026-10-19 12:00 - "Forrest Gump"
+Stopped watching.
+`, output.String())
+
+	output.Reset()
+
+	ctx, cancel = context.WithCancel(t.Context())
+	withWatch(t, dir).WithDependencies(output, client, ticks(1, cancel)).Watch(ctx, "playing", "1", time.Hour)
+
+	assert.Equal(t, "2026-10-19 12:00 - \"Fight Club\"\nStopped watching.\n", output.String())
+	assert.Equal(t, map[int]string{680: "Pulp Fiction"}, fp.Must(snapshot.Open(dir, "playing")).Movies)
+}
+
+func TestTMDBWatchLatest(t *testing.T) {
+	t.Parallel()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetLatestMovie", mock.Anything).Once().Return(tracked(1000, "Newest"), nil)
+	client.On("GetLatestMovie", mock.Anything).Once().Return(tracked(1001, "Newer"), nil)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	obj := withWatch(t, t.TempDir()).WithDependencies(output, client, ticks(2, cancel))
+
+	obj.Watch(ctx, "latest", "", time.Minute)
+
+	assert.Equal(t, "2026-10-19 12:00 watching 1 \"latest\" movies.\n2026-10-19 12:00 + \"Newer\"\nStopped watching.\n",
+		output.String())
+}
+
+func TestTMDBWatchPollFailure(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	output := new(strings.Builder)
+	client := mocks.NewMockClient(t)
+	client.On("GetPopularMovies", mock.Anything, 1).Once().Return(moviesOf(tracked(550, "Fight Club").Movie), nil)
+	client.On("GetPopularMovies", mock.Anything, 2).Once().Return(moviesOf(tracked(13, "Forrest Gump").Movie), nil)
+	client.On("GetPopularMovies", mock.Anything, 1).Once().Return(moviesOf(tracked(550, "Fight Club").Movie), nil)
+	client.On("GetPopularMovies", mock.Anything, 2).Once().Return(nil, errFail)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	obj := withWatch(t, dir).WithDependencies(output, client, ticks(2, cancel))
+
+	obj.Watch(ctx, "popular", "1-2", time.Hour)
+
+	assert.Equal(t, "2026-10-19 12:00 watching 2 \"popular\" movies.\nSomething went wrong.\nStopped watching.\n",
+		output.String())
+	assert.Len(t, fp.Must(snapshot.Open(dir, "popular")).Movies, 2)
+}
+
+func TestTMDBWatchInvalid(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		kind     string
+		pages    string
+		interval time.Duration
+	}
+
+	tests := []struct {
+		name string
+		want string
+		args args
+	}{
+		{
+			name: "type",
+			args: args{kind: "trending", pages: "", interval: time.Hour},
+			want: `Unknown "-type" value for watch. Allowed [playing,popular,top,upcoming,latest]`,
+		},
+		{
+			name: "interval",
+			args: args{kind: "playing", pages: "", interval: time.Second},
+			want: "Invalid interval: Lists are polled at most once a minute.",
+		},
+		{
+			name: "pages",
+			args: args{kind: "playing", pages: "5-1", interval: time.Hour},
+			want: `Invalid pages: Expected a range like "1-10". Pages start at 1 and max at 500.`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := new(strings.Builder)
+			obj := withWatch(t, t.TempDir())
+
+			obj.WithDependencies(output, mocks.NewMockClient(t)).
+				Watch(t.Context(), test.args.kind, test.args.pages, test.args.interval)
+
+			assert.Equal(t, test.want+"\n", output.String())
+		})
+	}
+}
+
+func TestTMDBWatchListsAtOnce(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fightClub := tracked(550, "Fight Club").Movie
+	forrestGump := tracked(13, "Forrest Gump").Movie
+
+	client := mocks.NewMockClient(t)
+	client.On("GetNowPlayingMovies", mock.Anything, 1).Return(moviesOf(fightClub), nil)
+	client.On("GetPopularMovies", mock.Anything, 1).Return(moviesOf(forrestGump), nil)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	playing := ticks(2, cancel)
+
+	// the popular watch runs its poll while the playing one waits for its next tick
+	between := func(interval time.Duration) <-chan time.Time {
+		popularCtx, popularCancel := context.WithCancel(t.Context())
+		withWatch(t, dir).WithDependencies(new(strings.Builder), client, ticks(1, popularCancel)).
+			Watch(popularCtx, "popular", "1", time.Hour)
+
+		return playing(interval)
+	}
+
+	withWatch(t, dir).WithDependencies(new(strings.Builder), client, between).Watch(ctx, "playing", "1", time.Hour)
+
+	assert.Equal(t, map[int]string{550: "Fight Club"}, fp.Must(snapshot.Open(dir, "playing")).Movies)
+	assert.Equal(t, map[int]string{13: "Forrest Gump"}, fp.Must(snapshot.Open(dir, "popular")).Movies)
+}
@@ -0,0 +1,60 @@
+// Package catalog keeps a local copy of the details of selected movies, refreshed by `tmdb sync`.
+package catalog
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/internal/jsonfile"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	service = "catalog.Catalog"
+
+	errCatalog = "catalogError"
+)
+
+// Catalog is the local copy. Synced is when the last complete sync started, the changes made since are yet to fetch.
+type Catalog struct {
+	Movies map[int]tmdb.MovieDetails `json:"movies"`
+	Synced time.Time                 `json:"synced"`
+	path   string
+}
+
+// Open reads the catalog kept at path. A missing file is an empty catalog.
+func Open(path string) (*Catalog, error) {
+	catalog := &Catalog{Movies: make(map[int]tmdb.MovieDetails), Synced: time.Time{}, path: path}
+
+	err := jsonfile.Read(path, catalog)
+	if err != nil {
+		return nil, oops.In(service).
+			Code(errCatalog).
+			With("path", path).
+			Public("Cannot read the catalog.").
+			Wrap(err)
+	}
+
+	if catalog.Movies == nil {
+		catalog.Movies = make(map[int]tmdb.MovieDetails)
+	}
+
+	return catalog, nil
+}
+
+// IDs lists the movies in the catalog in ascending order.
+func (c *Catalog) IDs() []int {
+	return slices.Sorted(maps.Keys(c.Movies))
+}
+
+// Save writes the catalog back.
+func (c *Catalog) Save() error {
+	return oops.In(service).
+		Code(errCatalog).
+		With("path", c.path).
+		Public("Cannot save the catalog.").
+		Wrap(jsonfile.Write(c.path, c))
+}
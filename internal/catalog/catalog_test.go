@@ -0,0 +1,94 @@
+package catalog_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/internal/catalog"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func TestOpenMissing(t *testing.T) {
+	t.Parallel()
+
+	got, err := catalog.Open(filepath.Join(t.TempDir(), "catalog.json"))
+
+	require.NoError(t, err)
+	assert.Empty(t, got.Movies)
+	assert.True(t, got.Synced.IsZero())
+}
+
+func TestCatalogSave(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "tmdb", "catalog.json")
+	synced := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	obj, err := catalog.Open(path)
+	require.NoError(t, err)
+
+	details := new(tmdb.MovieDetails)
+	details.ID = 550
+	details.Title = "Fight Club"
+
+	obj.Movies[550] = *details
+	obj.Movies[13] = *new(tmdb.MovieDetails)
+	obj.Synced = synced
+
+	require.NoError(t, obj.Save())
+
+	info, err := os.Stat(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	got, err := catalog.Open(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{13, 550}, got.IDs())
+	assert.Equal(t, "Fight Club", got.Movies[550].Title)
+	assert.True(t, synced.Equal(got.Synced))
+}
+
+func TestOpenFailure(t *testing.T) {
+	t.Parallel()
+
+	var orr oops.OopsError
+
+	path := filepath.Join(t.TempDir(), "catalog.json")
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+
+	_, err := catalog.Open(path)
+
+	require.ErrorAs(t, err, &orr)
+	assert.Equal(t, "Cannot read the catalog.", orr.Public())
+}
+
+func TestCatalogSaveFailure(t *testing.T) {
+	t.Parallel()
+
+	var orr oops.OopsError
+
+	path := filepath.Join(t.TempDir(), "catalog.json")
+
+	obj, err := catalog.Open(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Mkdir(path, 0o700))
+
+	err = obj.Save()
+
+	require.ErrorAs(t, err, &orr)
+	assert.Equal(t, "Cannot save the catalog.", orr.Public())
+}
@@ -2,12 +2,19 @@ package config
 
 import (
 	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/samber/oops"
 	"github.com/sethvargo/go-envconfig"
 
+	"github.com/therenotomorrow/tmdb/internal/jsonfile"
 	"github.com/therenotomorrow/tmdb/pkg/tmdb"
 )
 
@@ -17,11 +24,36 @@ const (
 	host    = "https://api.themoviedb.org"
 	timeout = 10 * time.Second
 
+	logFormatText = "text"
+	logFormatJSON = "json"
+
+	// sessionOff as TMDB_SESSION_FILE neither reads nor saves a session.
+	sessionOff  = "off"
+	sessionKey  = "TMDB_SESSION_ID"
+	accessKey   = "TMDB_ACCESS_TOKEN"
+	configDir   = "tmdb"
+	sessionName = "session.env"
+	catalogName = "catalog.json"
+	watchName   = "watch"
+
 	errInvalidConfig = "invalidConfig"
+	errSession       = "sessionError"
+	errCatalog       = "catalogError"
+	errWatch         = "watchError"
 )
 
 type Settings struct {
-	Token string `env:"TMDB_TOKEN" json:"token"`
+	Token       string `env:"TMDB_TOKEN"   json:"token"`
+	APIKey      string `env:"TMDB_API_KEY" json:"apiKey"`
+	LogFormat   string `env:"TMDB_LOG_FORMAT, default=text" json:"logFormat"`
+	LogLevel    string `env:"TMDB_LOG_LEVEL, default=warn" json:"logLevel"`
+	SessionID   string `env:"TMDB_SESSION_ID" json:"sessionId"`
+	SessionFile string `env:"TMDB_SESSION_FILE" json:"sessionFile"`
+	AccessToken string `env:"TMDB_ACCESS_TOKEN" json:"accessToken"`
+	CatalogFile string `env:"TMDB_CATALOG_FILE" json:"catalogFile"`
+	WatchDir    string `env:"TMDB_WATCH_DIR" json:"watchDir"`
+	Language    string `env:"TMDB_LANGUAGE" json:"language"`
+	Region      string `env:"TMDB_REGION" json:"region"`
 	tmdb.Config
 	Debug bool `env:"TMDB_DEBUG" json:"debug"`
 }
@@ -35,14 +67,36 @@ func New(filenames ...string) (Settings, error) {
 
 	err := envconfig.Process(context.Background(), &settings)
 	if err != nil {
-		return settings, errBuilder.Wrap(err)
+		return Settings{}, errBuilder.Wrap(err)
+	}
+
+	if settings.SessionID == "" {
+		settings.SessionID = settings.stored(sessionKey)
+	}
+
+	if settings.AccessToken == "" {
+		settings.AccessToken = settings.stored(accessKey)
 	}
 
 	settings.Config = tmdb.Config{
-		Debug:   settings.Debug,
-		Timeout: timeout,
-		Host:    host,
-		Token:   settings.Token,
+		Logger:         nil,
+		Tracing:        nil,
+		Metrics:        nil,
+		Breaker:        nil,
+		Timeout:        timeout,
+		Host:           host,
+		Token:          settings.Token,
+		Auth:           "",
+		SessionID:      settings.SessionID,
+		GuestSessionID: "",
+		AccessToken:    settings.AccessToken,
+		Language:       settings.Language,
+		Region:         settings.Region,
+	}
+
+	if settings.Token == "" && settings.APIKey != "" {
+		settings.Config.Token = settings.APIKey
+		settings.Config.Auth = tmdb.AuthAPIKey
 	}
 
 	return settings, nil
@@ -51,4 +105,164 @@ func New(filenames ...string) (Settings, error) {
 func (s *Settings) SetToken(token string) {
 	s.Token = token
 	s.Config.Token = token
+	s.Config.Auth = ""
+}
+
+// SetLocale overrides the language and the region, empty values keep the configured ones.
+func (s *Settings) SetLocale(language, region string) {
+	if language != "" {
+		s.Language = language
+		s.Config.Language = language
+	}
+
+	if region != "" {
+		s.Region = region
+		s.Config.Region = region
+	}
+}
+
+func (s *Settings) NewLogger(output io.Writer) (*slog.Logger, error) {
+	var level slog.Level
+
+	errBuilder := oops.In(service).Code(errInvalidConfig).With("format", s.LogFormat).With("level", s.LogLevel)
+
+	err := level.UnmarshalText([]byte(s.LogLevel))
+	if err != nil {
+		return nil, errBuilder.Public("Invalid log level: Allowed [debug,info,warn,error].").Wrap(err)
+	}
+
+	opts := &slog.HandlerOptions{AddSource: false, Level: level, ReplaceAttr: nil}
+
+	switch s.LogFormat {
+	case logFormatText:
+		return slog.New(slog.NewTextHandler(output, opts)), nil
+	case logFormatJSON:
+		return slog.New(slog.NewJSONHandler(output, opts)), nil
+	default:
+		return nil, errBuilder.Public("Invalid log format: Allowed [text,json].").New("invalid log format")
+	}
+}
+
+// SessionPath is the file SaveSession and SaveAccessToken write to: TMDB_SESSION_FILE or `tmdb/session.env` in the
+// user config directory. There is none when TMDB_SESSION_FILE is `off`.
+func (s *Settings) SessionPath() (string, error) {
+	if s.SessionFile == sessionOff {
+		return "", oops.In(service).Code(errSession).
+			Public("Cannot save the session: TMDB_SESSION_FILE is off.").
+			New("session file is off")
+	}
+
+	return userPath(s.SessionFile, sessionName, errSession, "session file")
+}
+
+// CatalogPath is the file `tmdb sync` keeps the catalog in: TMDB_CATALOG_FILE or `tmdb/catalog.json` in the user
+// config directory.
+func (s *Settings) CatalogPath() (string, error) {
+	return userPath(s.CatalogFile, catalogName, errCatalog, "catalog file")
+}
+
+// WatchPath is the directory `tmdb watch` keeps the last poll of each list in: TMDB_WATCH_DIR or `tmdb/watch` in the
+// user config directory.
+func (s *Settings) WatchPath() (string, error) {
+	return userPath(s.WatchDir, watchName, errWatch, "watch directory")
+}
+
+// SaveSession keeps sessionID for the next runs, readable by the current user only.
+func (s *Settings) SaveSession(sessionID string) error {
+	err := s.store(sessionKey, sessionID)
+	if err != nil {
+		return err
+	}
+
+	s.SessionID = sessionID
+	s.Config.SessionID = sessionID
+
+	return nil
+}
+
+// SaveAccessToken keeps the v4 user access token next to the session.
+func (s *Settings) SaveAccessToken(accessToken string) error {
+	err := s.store(accessKey, accessToken)
+	if err != nil {
+		return err
+	}
+
+	s.AccessToken = accessToken
+	s.Config.AccessToken = accessToken
+
+	return nil
+}
+
+// ForgetSession removes the saved session and access token, if any.
+func (s *Settings) ForgetSession() error {
+	if s.SessionFile != sessionOff {
+		path, err := s.SessionPath()
+		if err != nil {
+			return err
+		}
+
+		err = os.Remove(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return oops.In(service).Code(errSession).Public("Cannot remove the session.").Wrap(err)
+		}
+	}
+
+	s.SessionID = ""
+	s.Config.SessionID = ""
+	s.AccessToken = ""
+	s.Config.AccessToken = ""
+
+	return nil
+}
+
+// userFile is override when set, or name in the `tmdb` directory of the user config directory.
+func userPath(override, name, code, what string) (string, error) {
+	if override != "" {
+		return override, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", oops.In(service).Code(code).Public("Cannot locate the " + what + ".").Wrap(err)
+	}
+
+	return filepath.Join(dir, configDir, name), nil
+}
+
+// store sets key in the session file and keeps the other keys there.
+func (s *Settings) store(key, value string) error {
+	errBuilder := oops.In(service).Code(errSession).Public("Cannot save the session.")
+
+	path, err := s.SessionPath()
+	if err != nil {
+		return err
+	}
+
+	stored, err := godotenv.Read(path)
+	if err != nil {
+		stored = make(map[string]string)
+	}
+
+	stored[key] = value
+
+	data, err := godotenv.Marshal(stored)
+	if err != nil {
+		return errBuilder.Wrap(err)
+	}
+
+	return errBuilder.Wrap(jsonfile.WriteRaw(path, []byte(data+"\n")))
+}
+
+func (s *Settings) stored(key string) string {
+	path, err := s.SessionPath()
+	if err != nil {
+		return ""
+	}
+
+	stored, err := godotenv.Read(path)
+	if err != nil {
+		return ""
+	}
+
+	return stored[key]
 }
@@ -1,7 +1,11 @@
 package config_test
 
 import (
+	"io"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,19 +19,53 @@ import (
 
 func want() config.Settings {
 	return config.Settings{
-		Debug: true,
-		Token: "secret",
+		Debug:       true,
+		Token:       "secret",
+		APIKey:      "",
+		LogFormat:   "text",
+		LogLevel:    "warn",
+		SessionID:   "",
+		SessionFile: "",
+		AccessToken: "",
+		CatalogFile: "",
+		WatchDir:    "",
+		Language:    "",
+		Region:      "",
 		Config: tmdb.Config{
-			Debug:   true,
-			Timeout: 10 * time.Second,
-			Host:    "https://api.themoviedb.org",
-			Token:   "secret",
+			Logger:         nil,
+			Tracing:        nil,
+			Metrics:        nil,
+			Breaker:        nil,
+			Timeout:        10 * time.Second,
+			Host:           "https://api.themoviedb.org",
+			Token:          "secret",
+			Auth:           "",
+			SessionID:      "",
+			GuestSessionID: "",
+			AccessToken:    "",
+			Language:       "",
+			Region:         "",
 		},
 	}
 }
 
+// configHome points the user config directory at a temporary one, so a session saved by `tmdb login` on the
+// machine is not picked up.
+func configHome(t *testing.T) string {
+	t.Helper()
+
+	home := t.TempDir()
+
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", home)
+	t.Setenv("TMDB_SESSION_FILE", "")
+
+	return home
+}
+
 func TestNewSuccessFromDotenv(t *testing.T) {
 	t.Setenv(t.Name(), "test")
+	configHome(t)
 
 	const filename = ".env.test"
 
@@ -42,6 +80,7 @@ func TestNewSuccessFromDotenv(t *testing.T) {
 
 func TestNewSuccessFromEnvironment(t *testing.T) {
 	t.Setenv(t.Name(), "test")
+	configHome(t)
 
 	t.Setenv("TMDB_DEBUG", "true")
 	t.Setenv("TMDB_TOKEN", "secret")
@@ -52,8 +91,68 @@ func TestNewSuccessFromEnvironment(t *testing.T) {
 	assert.Equal(t, want(), got)
 }
 
+func TestNewSuccessFromAPIKey(t *testing.T) {
+	t.Setenv(t.Name(), "test")
+	configHome(t)
+
+	t.Setenv("TMDB_DEBUG", "false")
+	t.Setenv("TMDB_TOKEN", "")
+	t.Setenv("TMDB_API_KEY", "apikey")
+
+	got, err := config.New("skip")
+
+	require.NoError(t, err)
+	assert.Equal(t, config.Settings{
+		Token:       "",
+		APIKey:      "apikey",
+		LogFormat:   "text",
+		LogLevel:    "warn",
+		SessionID:   "",
+		SessionFile: "",
+		AccessToken: "",
+		CatalogFile: "",
+		WatchDir:    "",
+		Language:    "",
+		Region:      "",
+		Config: tmdb.Config{
+			Logger:         nil,
+			Tracing:        nil,
+			Metrics:        nil,
+			Breaker:        nil,
+			Timeout:        10 * time.Second,
+			Host:           "https://api.themoviedb.org",
+			Token:          "apikey",
+			Auth:           tmdb.AuthAPIKey,
+			SessionID:      "",
+			GuestSessionID: "",
+			AccessToken:    "",
+			Language:       "",
+			Region:         "",
+		},
+		Debug: false,
+	}, got)
+}
+
+func TestNewSuccessTokenOverAPIKey(t *testing.T) {
+	t.Setenv(t.Name(), "test")
+	configHome(t)
+
+	t.Setenv("TMDB_DEBUG", "true")
+	t.Setenv("TMDB_TOKEN", "secret")
+	t.Setenv("TMDB_API_KEY", "apikey")
+
+	want := want()
+	want.APIKey = "apikey"
+
+	got, err := config.New("skip")
+
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
 func TestNewFailure(t *testing.T) {
 	t.Setenv(t.Name(), "test")
+	configHome(t)
 
 	t.Setenv("TMDB_DEBUG", "invalid")
 	t.Setenv("TMDB_TOKEN", "")
@@ -76,8 +175,255 @@ func TestSettingsSetToken(t *testing.T) {
 
 	var obj config.Settings
 
+	obj.Config.Auth = tmdb.AuthAPIKey
+
 	obj.SetToken(want)
 
 	assert.Equal(t, want, obj.Token)
 	assert.Equal(t, want, obj.Config.Token)
+	assert.Empty(t, obj.Config.Auth)
+}
+
+func TestNewSuccessLocale(t *testing.T) {
+	t.Setenv(t.Name(), "test")
+	configHome(t)
+
+	t.Setenv("TMDB_DEBUG", "true")
+	t.Setenv("TMDB_TOKEN", "secret")
+	t.Setenv("TMDB_LANGUAGE", "de")
+	t.Setenv("TMDB_REGION", "DE")
+
+	got, err := config.New("skip")
+
+	require.NoError(t, err)
+	assert.Equal(t, "de", got.Config.Language)
+	assert.Equal(t, "DE", got.Config.Region)
+
+	got.SetLocale("pt-BR", "")
+
+	assert.Equal(t, "pt-BR", got.Language)
+	assert.Equal(t, "pt-BR", got.Config.Language)
+	assert.Equal(t, "DE", got.Config.Region)
+}
+
+func TestSettingsNewLogger(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		format string
+		level  string
+	}
+
+	tests := []struct {
+		name string
+		want string
+		args args
+	}{
+		{name: "text", args: args{format: "text", level: "info"}, want: "level=INFO msg=hello\n"},
+		{name: "json", args: args{format: "json", level: "DEBUG"}, want: `{"level":"INFO","msg":"hello"}` + "\n"},
+		{name: "filtered", args: args{format: "text", level: "error"}, want: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				obj    config.Settings
+				output strings.Builder
+			)
+
+			obj.LogFormat = test.args.format
+			obj.LogLevel = test.args.level
+
+			logger, err := obj.NewLogger(&output)
+
+			require.NoError(t, err)
+
+			logger.Info("hello")
+
+			assert.Equal(t, test.want, stripTime(output.String()))
+		})
+	}
+}
+
+func TestSettingsNewLoggerFailure(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		format string
+		level  string
+	}
+
+	tests := []struct {
+		name   string
+		public string
+		args   args
+	}{
+		{
+			name:   "invalid level",
+			args:   args{format: "text", level: "loud"},
+			public: "Invalid log level: Allowed [debug,info,warn,error].",
+		},
+		{
+			name:   "invalid format",
+			args:   args{format: "yaml", level: "info"},
+			public: "Invalid log format: Allowed [text,json].",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				obj config.Settings
+				orr oops.OopsError
+			)
+
+			obj.LogFormat = test.args.format
+			obj.LogLevel = test.args.level
+
+			logger, err := obj.NewLogger(io.Discard)
+
+			require.ErrorAs(t, err, &orr)
+
+			assert.Equal(t, test.public, orr.Public())
+			assert.Nil(t, logger)
+		})
+	}
+}
+
+func stripTime(line string) string {
+	line = regexp.MustCompile(`time=\S+ `).ReplaceAllString(line, "")
+
+	return regexp.MustCompile(`"time":"[^"]+",`).ReplaceAllString(line, "")
+}
+
+func TestSettingsSaveSession(t *testing.T) {
+	t.Parallel()
+
+	var obj config.Settings
+
+	obj.SessionFile = filepath.Join(t.TempDir(), "tmdb", "session.env")
+
+	err := obj.SaveSession("session-id")
+
+	require.NoError(t, err)
+	assert.Equal(t, "session-id", obj.SessionID)
+
+	info, err := os.Stat(obj.SessionFile)
+
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	err = obj.ForgetSession()
+
+	require.NoError(t, err)
+	assert.Empty(t, obj.SessionID)
+	assert.NoFileExists(t, obj.SessionFile)
+
+	err = obj.ForgetSession()
+
+	require.NoError(t, err)
+}
+
+func TestSettingsSaveAccessTokenTightensMode(t *testing.T) {
+	t.Parallel()
+
+	var obj config.Settings
+
+	obj.SessionFile = filepath.Join(t.TempDir(), "session.env")
+
+	require.NoError(t, os.WriteFile(obj.SessionFile, []byte("TMDB_SESSION_ID=stored-session\n"), 0o644))
+	require.NoError(t, os.Chmod(obj.SessionFile, 0o644))
+	require.NoError(t, obj.SaveAccessToken("access-token"))
+
+	info, err := os.Stat(obj.SessionFile)
+
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	data, err := os.ReadFile(obj.SessionFile)
+
+	require.NoError(t, err)
+	assert.Equal(t, "TMDB_ACCESS_TOKEN=\"access-token\"\nTMDB_SESSION_ID=\"stored-session\"\n", string(data))
+}
+
+func TestSettingsSaveSessionFailure(t *testing.T) {
+	t.Parallel()
+
+	var (
+		obj config.Settings
+		orr oops.OopsError
+	)
+
+	obj.SessionFile = t.TempDir()
+
+	err := obj.SaveSession("session-id")
+
+	require.ErrorAs(t, err, &orr)
+	assert.Equal(t, "Cannot save the session.", orr.Public())
+	assert.Empty(t, obj.SessionID)
+}
+
+func TestNewSuccessFromSessionFile(t *testing.T) {
+	t.Setenv(t.Name(), "test")
+	configHome(t)
+
+	var saved config.Settings
+
+	saved.SessionFile = filepath.Join(t.TempDir(), "session.env")
+
+	require.NoError(t, saved.SaveSession("saved-session"))
+	require.NoError(t, saved.SaveAccessToken("saved-token"))
+
+	t.Setenv("TMDB_TOKEN", "secret")
+	t.Setenv("TMDB_SESSION_FILE", saved.SessionFile)
+
+	got, err := config.New("skip")
+
+	require.NoError(t, err)
+	assert.Equal(t, "saved-session", got.SessionID)
+	assert.Equal(t, "saved-token", got.Config.AccessToken)
+
+	t.Setenv("TMDB_SESSION_ID", "env-session")
+
+	got, err = config.New("skip")
+
+	require.NoError(t, err)
+	assert.Equal(t, "env-session", got.SessionID)
+}
+
+func TestNewSessionFileOff(t *testing.T) {
+	t.Setenv(t.Name(), "test")
+
+	home := configHome(t)
+	stored := filepath.Join(home, "tmdb", "session.env")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(stored), 0o700))
+	require.NoError(t, os.WriteFile(stored, []byte("TMDB_SESSION_ID=stored-session\n"), 0o600))
+
+	t.Setenv("TMDB_TOKEN", "secret")
+
+	got, err := config.New("skip")
+
+	require.NoError(t, err)
+	assert.Equal(t, "stored-session", got.SessionID)
+
+	t.Setenv("TMDB_SESSION_FILE", "off")
+
+	got, err = config.New("skip")
+
+	require.NoError(t, err)
+	assert.Empty(t, got.SessionID)
+
+	var orr oops.OopsError
+
+	err = got.SaveSession("session-id")
+
+	require.ErrorAs(t, err, &orr)
+	assert.Equal(t, "Cannot save the session: TMDB_SESSION_FILE is off.", orr.Public())
+	require.NoError(t, got.ForgetSession())
+	assert.FileExists(t, stored)
 }
@@ -0,0 +1,77 @@
+// Package jsonfile keeps the state of the CLI, such as the catalog or the watch state, in JSON files.
+package jsonfile
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/samber/oops"
+)
+
+const (
+	service = "jsonfile"
+
+	errFile = "fileError"
+
+	dirPerm = 0o700
+)
+
+// Read decodes the file at path into value. A missing file leaves value as it is.
+func Read(path string, value any) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	errBuilder := oops.In(service).Code(errFile).With("path", path)
+
+	if err != nil {
+		return errBuilder.Wrap(err)
+	}
+
+	return errBuilder.Wrap(json.Unmarshal(data, value))
+}
+
+// Write encodes value to the file at path the way WriteRaw writes it.
+func Write(path string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return oops.In(service).Code(errFile).With("path", path).Wrap(err)
+	}
+
+	return WriteRaw(path, data)
+}
+
+// WriteRaw writes data to the file at path, readable by the current user only even when it was not before. The file
+// is replaced at once by a temporary one written next to it, so a crash during the write leaves the previous content
+// intact.
+func WriteRaw(path string, data []byte) error {
+	errBuilder := oops.In(service).Code(errFile).With("path", path)
+	dir := filepath.Dir(path)
+
+	err := os.MkdirAll(dir, dirPerm)
+	if err != nil {
+		return errBuilder.Wrap(err)
+	}
+
+	// CreateTemp makes the file readable by the current user only
+	temp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errBuilder.Wrap(err)
+	}
+
+	// after the rename there is nothing left to remove
+	defer func() { _ = os.Remove(temp.Name()) }()
+
+	_, err = temp.Write(data)
+
+	err = errors.Join(err, temp.Close())
+	if err != nil {
+		return errBuilder.Wrap(err)
+	}
+
+	return errBuilder.Wrap(os.Rename(temp.Name(), path))
+}
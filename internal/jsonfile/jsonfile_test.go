@@ -0,0 +1,106 @@
+package jsonfile_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/internal/jsonfile"
+)
+
+func TestReadMissing(t *testing.T) {
+	t.Parallel()
+
+	got := map[string]int{"kept": 1}
+
+	require.NoError(t, jsonfile.Read(filepath.Join(t.TempDir(), "state.json"), &got))
+	assert.Equal(t, map[string]int{"kept": 1}, got)
+}
+
+func TestReadFailure(t *testing.T) {
+	t.Parallel()
+
+	var got map[string]int
+
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	require.Error(t, jsonfile.Read(path, &got))
+	require.Error(t, jsonfile.Read(t.TempDir(), &got))
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	var got map[string]int
+
+	dir := filepath.Join(t.TempDir(), "tmdb")
+	path := filepath.Join(dir, "state.json")
+
+	require.NoError(t, jsonfile.Write(path, map[string]int{"first": 1}))
+	require.NoError(t, jsonfile.Write(path, map[string]int{"second": 2}))
+
+	info, err := os.Stat(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	require.NoError(t, jsonfile.Read(path, &got))
+	assert.Equal(t, map[string]int{"second": 2}, got)
+
+	entries, err := os.ReadDir(dir)
+
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestWriteConcurrently(t *testing.T) {
+	t.Parallel()
+
+	var (
+		group sync.WaitGroup
+		got   map[string]int
+	)
+
+	path := filepath.Join(t.TempDir(), "state.json")
+	errs := make([]error, 8)
+
+	for idx := range errs {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			errs[idx] = jsonfile.Write(path, map[string]int{strconv.Itoa(idx): idx})
+		}()
+	}
+
+	group.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, jsonfile.Read(path, &got))
+	assert.Len(t, got, 1)
+}
+
+func TestWriteFailure(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	require.NoError(t, os.Mkdir(path, 0o700))
+	require.Error(t, jsonfile.Write(path, map[string]int{}))
+	require.Error(t, jsonfile.Write(path, func() {}))
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -0,0 +1,79 @@
+// Package snapshot keeps what `tmdb watch` saw in a list at its last poll, so a restart reports only what changed
+// in between.
+package snapshot
+
+import (
+	"maps"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/therenotomorrow/tmdb/internal/jsonfile"
+)
+
+const (
+	service = "snapshot.Snapshot"
+
+	errSnapshot = "snapshotError"
+)
+
+// Snapshot is the titles of a list by movie id, as of Polled. Polled is zero for a list never polled.
+type Snapshot struct {
+	Movies map[int]string `json:"movies"`
+	Polled time.Time      `json:"polled"`
+}
+
+// Open reads the snapshot of the kind list kept in dir. Every list has a file of its own, so watching several lists
+// at once never loses a poll.
+func Open(dir, kind string) (Snapshot, error) {
+	last := Snapshot{Movies: make(map[int]string), Polled: time.Time{}}
+	path := filepath.Join(dir, kind+".json")
+
+	err := jsonfile.Read(path, &last)
+	if err != nil {
+		return last, oops.In(service).
+			Code(errSnapshot).
+			With("path", path).
+			Public("Cannot read the watch state.").
+			Wrap(err)
+	}
+
+	if last.Movies == nil {
+		last.Movies = make(map[int]string)
+	}
+
+	return last, nil
+}
+
+// Save writes the snapshot of the kind list to dir.
+func (s Snapshot) Save(dir, kind string) error {
+	path := filepath.Join(dir, kind+".json")
+
+	return oops.In(service).
+		Code(errSnapshot).
+		With("path", path).
+		Public("Cannot save the watch state.").
+		Wrap(jsonfile.Write(path, s))
+}
+
+// Diff returns the ids in next but not in s and those in s but not in next, both in ascending order.
+func (s Snapshot) Diff(next Snapshot) ([]int, []int) {
+	added := make([]int, 0)
+	dropped := make([]int, 0)
+
+	for _, movieID := range slices.Sorted(maps.Keys(next.Movies)) {
+		if _, ok := s.Movies[movieID]; !ok {
+			added = append(added, movieID)
+		}
+	}
+
+	for _, movieID := range slices.Sorted(maps.Keys(s.Movies)) {
+		if _, ok := next.Movies[movieID]; !ok {
+			dropped = append(dropped, movieID)
+		}
+	}
+
+	return added, dropped
+}
@@ -0,0 +1,98 @@
+package snapshot_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/internal/snapshot"
+)
+
+func TestOpenMissing(t *testing.T) {
+	t.Parallel()
+
+	got, err := snapshot.Open(t.TempDir(), "playing")
+
+	require.NoError(t, err)
+	assert.Empty(t, got.Movies)
+	assert.True(t, got.Polled.IsZero())
+}
+
+func TestSnapshotSave(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "tmdb", "watch")
+	polled := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	playing := snapshot.Snapshot{Movies: map[int]string{550: "Fight Club"}, Polled: polled}
+	popular := snapshot.Snapshot{Movies: map[int]string{13: "Forrest Gump"}, Polled: polled}
+
+	require.NoError(t, playing.Save(dir, "playing"))
+	require.NoError(t, popular.Save(dir, "popular"))
+
+	info, err := os.Stat(filepath.Join(dir, "playing.json"))
+
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	got, err := snapshot.Open(dir, "playing")
+
+	require.NoError(t, err)
+	assert.Equal(t, map[int]string{550: "Fight Club"}, got.Movies)
+	assert.True(t, polled.Equal(got.Polled))
+
+	got, err = snapshot.Open(dir, "popular")
+
+	require.NoError(t, err)
+	assert.Equal(t, map[int]string{13: "Forrest Gump"}, got.Movies)
+}
+
+func TestOpenFailure(t *testing.T) {
+	t.Parallel()
+
+	var orr oops.OopsError
+
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "playing.json"), []byte("["), 0o600))
+
+	_, err := snapshot.Open(dir, "playing")
+
+	require.ErrorAs(t, err, &orr)
+	assert.Equal(t, "Cannot read the watch state.", orr.Public())
+}
+
+func TestSnapshotSaveFailure(t *testing.T) {
+	t.Parallel()
+
+	var orr oops.OopsError
+
+	dir := t.TempDir()
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "playing.json"), 0o700))
+
+	err := snapshot.Snapshot{Movies: nil, Polled: time.Time{}}.Save(dir, "playing")
+
+	require.ErrorAs(t, err, &orr)
+	assert.Equal(t, "Cannot save the watch state.", orr.Public())
+}
+
+func TestSnapshotDiff(t *testing.T) {
+	t.Parallel()
+
+	previous := snapshot.Snapshot{Movies: map[int]string{550: "Fight Club", 13: "Forrest Gump"}, Polled: time.Time{}}
+	next := snapshot.Snapshot{
+		Movies: map[int]string{680: "Pulp Fiction", 550: "Fight Club", 1: "A"},
+		Polled: time.Time{},
+	}
+
+	added, dropped := previous.Diff(next)
+
+	assert.Equal(t, []int{1, 680}, added)
+	assert.Equal(t, []int{13}, dropped)
+}
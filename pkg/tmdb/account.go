@@ -0,0 +1,180 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/samber/oops"
+)
+
+const (
+	pathAccount       = "/3/account"
+	pathAccountStates = "/account_states"
+
+	MediaMovie  MediaType = "movie"
+	MediaTV     MediaType = "tv"
+	MediaPerson MediaType = "person"
+
+	collectionFavorite  = "favorite"
+	collectionWatchlist = "watchlist"
+	collectionRated     = "rated"
+)
+
+// MediaType names what an account collection item is: a movie or a TV show. Search results may be people too.
+type MediaType string
+
+func (c *TMDB) GetAccount(ctx context.Context) (Account, error) {
+	var data Account
+
+	params, err := c.session(false)
+	if err != nil {
+		return data, err
+	}
+
+	return data, c.get(ctx, "GetAccount", pathAccount, params, &data)
+}
+
+// SetFavorite adds the movie or TV show to the account favorites, or removes it when favorite is false.
+func (c *TMDB) SetFavorite(ctx context.Context, media MediaType, mediaID int, favorite bool) error {
+	return c.mark(ctx, "SetFavorite", collectionFavorite, media, mediaID, favorite)
+}
+
+// SetWatchlist adds the movie or TV show to the account watchlist, or removes it when watchlist is false.
+func (c *TMDB) SetWatchlist(ctx context.Context, media MediaType, mediaID int, watchlist bool) error {
+	return c.mark(ctx, "SetWatchlist", collectionWatchlist, media, mediaID, watchlist)
+}
+
+func (c *TMDB) GetFavoriteMovies(ctx context.Context, page int) (MoviesPage, error) {
+	return accountPage[Movie](ctx, c, "GetFavoriteMovies", collectionFavorite+"/movies", page)
+}
+
+func (c *TMDB) GetFavoriteTV(ctx context.Context, page int) (Page[TVShow], error) {
+	return accountPage[TVShow](ctx, c, "GetFavoriteTV", collectionFavorite+"/tv", page)
+}
+
+func (c *TMDB) GetWatchlistMovies(ctx context.Context, page int) (MoviesPage, error) {
+	return accountPage[Movie](ctx, c, "GetWatchlistMovies", collectionWatchlist+"/movies", page)
+}
+
+func (c *TMDB) GetWatchlistTV(ctx context.Context, page int) (Page[TVShow], error) {
+	return accountPage[TVShow](ctx, c, "GetWatchlistTV", collectionWatchlist+"/tv", page)
+}
+
+func (c *TMDB) GetRatedMovies(ctx context.Context, page int) (Page[RatedMovie], error) {
+	return accountPage[RatedMovie](ctx, c, "GetRatedMovies", collectionRated+"/movies", page)
+}
+
+func (c *TMDB) GetRatedTV(ctx context.Context, page int) (Page[RatedTVShow], error) {
+	return accountPage[RatedTVShow](ctx, c, "GetRatedTV", collectionRated+"/tv", page)
+}
+
+// GetAccountStates tells whether the movie is a favorite, in the watchlist or rated. Guest sessions only see their
+// ratings.
+func (c *TMDB) GetAccountStates(ctx context.Context, movieID int) (AccountStates, error) {
+	var data AccountStates
+
+	params, err := c.session(true)
+	if err != nil {
+		return data, err
+	}
+
+	return data, c.get(ctx, "GetAccountStates", pathMovie+strconv.Itoa(movieID)+pathAccountStates, params, &data)
+}
+
+// UnmarshalJSON reads `rated`, which TMDB sends as false or as an object holding the value.
+func (s *AccountStates) UnmarshalJSON(data []byte) error {
+	type plain AccountStates
+
+	var raw struct {
+		Rated json.RawMessage `json:"rated"`
+		plain
+	}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return oops.Wrap(err)
+	}
+
+	*s = AccountStates(raw.plain)
+
+	var rated struct {
+		Value float64 `json:"value"`
+	}
+
+	if json.Unmarshal(raw.Rated, &rated) == nil {
+		s.Rated = rated.Value
+	}
+
+	return nil
+}
+
+func (c *TMDB) mark(ctx context.Context, operation, collection string, media MediaType, mediaID int, value bool) error {
+	var data errorResponse
+
+	if err := c.media(media); err != nil {
+		return err
+	}
+
+	path, params, err := c.account(ctx, collection)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]any{"media_type": media, "media_id": mediaID, collection: value}
+
+	return c.send(ctx, http.MethodPost, operation, path, params, body, &data)
+}
+
+func (c *TMDB) media(media MediaType) error {
+	if media != MediaMovie && media != MediaTV {
+		return c.oops.Code(errInvalidArgument).
+			With("media", media).
+			Public("Unknown media type: Allowed [movie,tv].").
+			New("invalid media type")
+	}
+
+	return nil
+}
+
+func accountPage[T any](ctx context.Context, client *TMDB, operation, collection string, page int) (Page[T], error) {
+	var data Page[T]
+
+	path, params, err := client.account(ctx, collection)
+	if err != nil {
+		return data, err
+	}
+
+	params["page"] = strconv.Itoa(page)
+
+	return data, client.get(ctx, operation, path, params, &data)
+}
+
+// account returns the path of an account collection with the session parameters. The account id is fetched once
+// per client, which is bound to a single session.
+func (c *TMDB) account(ctx context.Context, collection string) (string, map[string]string, error) {
+	params, err := c.session(false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	c.mutex.Lock()
+	accountID := c.accountID
+	c.mutex.Unlock()
+
+	if accountID == 0 {
+		account, err := c.GetAccount(ctx)
+		if err != nil {
+			return "", nil, err
+		}
+
+		accountID = account.ID
+
+		c.mutex.Lock()
+		c.accountID = accountID
+		c.mutex.Unlock()
+	}
+
+	return pathAccount + "/" + strconv.Itoa(accountID) + "/" + collection, params, nil
+}
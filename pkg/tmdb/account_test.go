@@ -0,0 +1,158 @@
+package tmdb_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
+)
+
+func TestTMDBGetAccount(t *testing.T) {
+	t.Parallel()
+
+	obj := fp.Must(tmdb.New(loggedIn(t, fixture(t))))
+
+	got, err := obj.GetAccount(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, tmdbtest.AccountID, got.ID)
+	assert.Equal(t, "tmdbtest", got.Username)
+}
+
+func TestTMDBWatchlist(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(loggedIn(t, server)))
+
+	require.NoError(t, obj.SetWatchlist(t.Context(), tmdb.MediaMovie, 550, true))
+	require.NoError(t, obj.SetWatchlist(t.Context(), tmdb.MediaMovie, 13, true))
+	require.NoError(t, obj.SetWatchlist(t.Context(), tmdb.MediaMovie, 550, true))
+	require.NoError(t, obj.SetWatchlist(t.Context(), tmdb.MediaTV, 1396, true))
+
+	movies, err := obj.GetWatchlistMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.Movie{newMovie(550, "Fight Club"), newMovie(13, "Forrest Gump")}, movies.Results)
+
+	shows, err := obj.GetWatchlistTV(t.Context(), 1)
+
+	require.NoError(t, err)
+	require.Len(t, shows.Results, 1)
+	assert.Equal(t, "Breaking Bad", shows.Results[0].Name)
+
+	require.NoError(t, obj.SetWatchlist(t.Context(), tmdb.MediaMovie, 550, false))
+
+	assert.Equal(t, []int{13}, server.Collection("watchlist", tmdb.MediaMovie))
+	assert.Equal(t, 1, server.Hits("/3/account"))
+}
+
+func TestTMDBFavorites(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(loggedIn(t, server)))
+
+	require.NoError(t, obj.SetFavorite(t.Context(), tmdb.MediaMovie, 13, true))
+	require.NoError(t, obj.SetFavorite(t.Context(), tmdb.MediaTV, 1396, true))
+
+	movies, err := obj.GetFavoriteMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.Movie{newMovie(13, "Forrest Gump")}, movies.Results)
+
+	shows, err := obj.GetFavoriteTV(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, shows.TotalResults)
+
+	require.NoError(t, obj.SetFavorite(t.Context(), tmdb.MediaTV, 1396, false))
+
+	assert.Empty(t, server.Collection("favorite", tmdb.MediaTV))
+
+	err = obj.SetFavorite(t.Context(), tmdb.MediaMovie, 404, true)
+
+	assertPublic(t, err, "The resource you requested could not be found.")
+
+	err = obj.SetFavorite(t.Context(), tmdb.MediaType("person"), 13, true)
+
+	assertPublic(t, err, "Unknown media type: Allowed [movie,tv].")
+}
+
+func TestTMDBRatedAndAccountStates(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(loggedIn(t, server)))
+
+	states, err := obj.GetAccountStates(t.Context(), 550)
+
+	require.NoError(t, err)
+	assert.Equal(t, tmdb.AccountStates{ID: 550, Rated: 0, Favorite: false, Watchlist: false}, states)
+
+	require.NoError(t, obj.RateMovie(t.Context(), 550, 9))
+	require.NoError(t, obj.SetFavorite(t.Context(), tmdb.MediaMovie, 550, true))
+
+	states, err = obj.GetAccountStates(t.Context(), 550)
+
+	require.NoError(t, err)
+	assert.Equal(t, tmdb.AccountStates{ID: 550, Rated: 9, Favorite: true, Watchlist: false}, states)
+
+	rated, err := obj.GetRatedMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.RatedMovie{{Movie: newMovie(550, "Fight Club"), Rating: 9}}, rated.Results)
+
+	shows, err := obj.GetRatedTV(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Empty(t, shows.Results)
+}
+
+func TestTMDBAccountWithoutSession(t *testing.T) {
+	t.Parallel()
+
+	const noSession = "Session required: Log in to act on behalf of a user."
+
+	server := fixture(t)
+	config := server.Config()
+	config.GuestSessionID = "guest"
+
+	obj := fp.Must(tmdb.New(config))
+
+	_, err := obj.GetAccount(t.Context())
+	assertPublic(t, err, noSession)
+
+	err = obj.SetWatchlist(t.Context(), tmdb.MediaMovie, 550, true)
+	assertPublic(t, err, noSession)
+
+	_, err = obj.GetFavoriteMovies(t.Context(), 1)
+	assertPublic(t, err, noSession)
+
+	config.GuestSessionID = ""
+	config.SessionID = "expired"
+
+	obj = fp.Must(tmdb.New(config))
+
+	_, err = obj.GetRatedMovies(t.Context(), 1)
+	assertPublic(t, err, "Authentication failed: You do not have permissions to access the service.")
+}
+
+func TestAccountStatesUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var got tmdb.AccountStates
+
+	require.NoError(t, json.Unmarshal([]byte(`{"id":1,"favorite":true,"rated":{"value":7.5},"watchlist":true}`), &got))
+	assert.Equal(t, tmdb.AccountStates{ID: 1, Rated: 7.5, Favorite: true, Watchlist: true}, got)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"id":2,"favorite":false,"rated":false,"watchlist":false}`), &got))
+	assert.Equal(t, tmdb.AccountStates{ID: 2, Rated: 0, Favorite: false, Watchlist: false}, got)
+
+	require.Error(t, json.Unmarshal([]byte(`{"id":"3"}`), &got))
+}
@@ -0,0 +1,131 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	pathRequestToken   = "/3/authentication/token/new"
+	pathSession        = "/3/authentication/session"
+	pathSessionNew     = "/3/authentication/session/new"
+	pathSessionConvert = "/3/authentication/session/convert/4"
+	pathGuestSession   = "/3/authentication/guest_session/new"
+	pathAccessRequest  = "/4/auth/request_token"
+	pathAccessToken    = "/4/auth/access_token"
+
+	approvalURL       = "https://www.themoviedb.org/authenticate/"
+	accessApprovalURL = "https://www.themoviedb.org/auth/access?request_token="
+)
+
+// ApprovalURL is the page where the user approves the request token before CreateSession.
+func (t RequestToken) ApprovalURL() string {
+	return approvalURL + t.RequestToken
+}
+
+// ApprovalURL is the page where the user approves the request token before CreateAccessToken.
+func (r AccessRequest) ApprovalURL() string {
+	return accessApprovalURL + r.RequestToken
+}
+
+func (c *TMDB) CreateRequestToken(ctx context.Context) (RequestToken, error) {
+	var data RequestToken
+
+	return data, c.get(ctx, "CreateRequestToken", pathRequestToken, make(map[string]string), &data)
+}
+
+// CreateSession exchanges a request token approved by the user for a session id.
+func (c *TMDB) CreateSession(ctx context.Context, requestToken string) (Session, error) {
+	var data Session
+
+	if requestToken == "" {
+		return data, c.invalidArgument("requestToken", "Request token is required.")
+	}
+
+	body := map[string]string{"request_token": requestToken}
+
+	params := make(map[string]string)
+
+	return data, c.send(ctx, http.MethodPost, "CreateSession", pathSessionNew, params, body, &data)
+}
+
+// CreateSessionFromV4 converts a v4 user access token into a v3 session id.
+func (c *TMDB) CreateSessionFromV4(ctx context.Context, accessToken string) (Session, error) {
+	var data Session
+
+	if accessToken == "" {
+		return data, c.invalidArgument("accessToken", "Access token is required.")
+	}
+
+	body := map[string]string{"access_token": accessToken}
+
+	params := make(map[string]string)
+
+	return data, c.send(ctx, http.MethodPost, "CreateSessionFromV4", pathSessionConvert, params, body, &data)
+}
+
+func (c *TMDB) CreateGuestSession(ctx context.Context) (GuestSession, error) {
+	var data GuestSession
+
+	return data, c.get(ctx, "CreateGuestSession", pathGuestSession, make(map[string]string), &data)
+}
+
+// DeleteSession logs the session out. Its id is unusable afterwards.
+func (c *TMDB) DeleteSession(ctx context.Context, sessionID string) error {
+	var data Session
+
+	if sessionID == "" {
+		return c.invalidArgument("sessionID", "Session id is required.")
+	}
+
+	body := map[string]string{"session_id": sessionID}
+
+	params := make(map[string]string)
+
+	return c.send(ctx, http.MethodDelete, "DeleteSession", pathSession, params, body, &data)
+}
+
+// CreateAccessRequest starts the v4 user authentication. It needs Token to be a v4 bearer token.
+func (c *TMDB) CreateAccessRequest(ctx context.Context) (AccessRequest, error) {
+	var data AccessRequest
+
+	body := make(map[string]string)
+
+	params := make(map[string]string)
+
+	return data, c.send(ctx, http.MethodPost, "CreateAccessRequest", pathAccessRequest, params, body, &data)
+}
+
+// CreateAccessToken exchanges a request token approved by the user for a v4 user access token.
+func (c *TMDB) CreateAccessToken(ctx context.Context, requestToken string) (AccessToken, error) {
+	var data AccessToken
+
+	if requestToken == "" {
+		return data, c.invalidArgument("requestToken", "Request token is required.")
+	}
+
+	body := map[string]string{"request_token": requestToken}
+
+	params := make(map[string]string)
+
+	return data, c.send(ctx, http.MethodPost, "CreateAccessToken", pathAccessToken, params, body, &data)
+}
+
+// DeleteAccessToken logs the v4 user out. The token is unusable afterwards.
+func (c *TMDB) DeleteAccessToken(ctx context.Context, accessToken string) error {
+	var data AccessToken
+
+	if accessToken == "" {
+		return c.invalidArgument("accessToken", "Access token is required.")
+	}
+
+	body := map[string]string{"access_token": accessToken}
+
+	params := make(map[string]string)
+
+	return c.send(ctx, http.MethodDelete, "DeleteAccessToken", pathAccessToken, params, body, &data)
+}
+
+func (c *TMDB) invalidArgument(name, public string) error {
+	return c.oops.Code(errInvalidArgument).With("argument", name).Public(public).New("invalid argument")
+}
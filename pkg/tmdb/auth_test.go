@@ -0,0 +1,106 @@
+package tmdb_test
+
+import (
+	"testing"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func TestTMDBSessionFlow(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	token, err := obj.CreateRequestToken(t.Context())
+
+	require.NoError(t, err)
+	assert.True(t, token.Success)
+	assert.NotEmpty(t, token.ExpiresAt)
+	assert.Equal(t, "https://www.themoviedb.org/authenticate/"+token.RequestToken, token.ApprovalURL())
+
+	_, err = obj.CreateSession(t.Context(), token.RequestToken)
+
+	assertPublic(t, err, "Session denied.")
+
+	server.Approve(token.RequestToken)
+
+	session, err := obj.CreateSession(t.Context(), token.RequestToken)
+
+	require.NoError(t, err)
+	assert.True(t, server.Session(session.SessionID))
+
+	_, err = obj.CreateSession(t.Context(), token.RequestToken)
+
+	assertPublic(t, err, "Invalid request token: The request token is either expired or invalid.")
+
+	err = obj.DeleteSession(t.Context(), session.SessionID)
+
+	require.NoError(t, err)
+	assert.False(t, server.Session(session.SessionID))
+
+	err = obj.DeleteSession(t.Context(), session.SessionID)
+
+	assertPublic(t, err, "Invalid id: The pre-requisite id is invalid or not found.")
+}
+
+func TestTMDBCreateSessionFromV4(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	session, err := obj.CreateSessionFromV4(t.Context(), "v4-access-token")
+
+	require.NoError(t, err)
+	assert.True(t, session.Success)
+	assert.True(t, server.Session(session.SessionID))
+}
+
+func TestTMDBCreateGuestSession(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	guest, err := obj.CreateGuestSession(t.Context())
+
+	require.NoError(t, err)
+	assert.True(t, guest.Success)
+	assert.NotEmpty(t, guest.GuestSessionID)
+	assert.NotEmpty(t, guest.ExpiresAt)
+	assert.False(t, server.Session(guest.GuestSessionID))
+}
+
+func TestTMDBAuthInvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	_, err := obj.CreateSession(t.Context(), "")
+	assertPublic(t, err, "Request token is required.")
+
+	_, err = obj.CreateSessionFromV4(t.Context(), "")
+	assertPublic(t, err, "Access token is required.")
+
+	err = obj.DeleteSession(t.Context(), "")
+	assertPublic(t, err, "Session id is required.")
+
+	assert.Zero(t, server.Hits("/3/authentication/session/new"))
+	assert.Zero(t, server.Hits("/3/authentication/session"))
+}
+
+func assertPublic(t *testing.T, err error, want string) {
+	t.Helper()
+
+	var orr oops.OopsError
+
+	require.ErrorAs(t, err, &orr)
+	assert.Equal(t, want, orr.Public())
+}
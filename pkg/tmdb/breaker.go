@@ -0,0 +1,223 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+const (
+	BreakerClosed   BreakerState = "closed"
+	BreakerOpen     BreakerState = "open"
+	BreakerHalfOpen BreakerState = "half-open"
+
+	errCircuitOpen = "circuitOpen"
+
+	defaultFailureRatio = 0.5
+	defaultMinRequests  = 10
+	defaultWindow       = time.Minute
+	defaultCooldown     = 30 * time.Second
+)
+
+type (
+	// BreakerState is the state of a Breaker: closed lets calls through, open rejects them, half-open lets a single
+	// probe through to decide whether to close again.
+	BreakerState string
+
+	// BreakerConfig tunes a Breaker. Zero fields fall back to a 0.5 ratio over at least 10 calls per minute and a
+	// 30 seconds cooldown.
+	BreakerConfig struct {
+		// FailureRatio of failed calls within Window that opens the breaker.
+		FailureRatio float64
+		// MinRequests within Window before FailureRatio is considered.
+		MinRequests int
+		// Window after which the closed breaker forgets its counts.
+		Window time.Duration
+		// Cooldown the breaker stays open before it lets a probe through.
+		Cooldown time.Duration
+	}
+
+	// Breaker is a circuit breaker for Config.Breaker. Transport errors, timeouts, 429 and 5xx responses count as
+	// failures; calls cancelled by their caller and other responses do not.
+	Breaker struct {
+		windowStart time.Time
+		openedAt    time.Time
+		state       BreakerState
+		config      BreakerConfig
+		failures    int
+		total       int
+		generation  int
+		mutex       sync.Mutex
+		probing     bool
+	}
+
+	// ticket is handed to each call let through: the generation, bumped whenever the breaker opens, tells the calls
+	// admitted before apart, and probe marks the single call of the half-open state.
+	ticket struct {
+		generation int
+		probe      bool
+	}
+
+	// CircuitOpenError is returned, wrapped, for calls rejected by an open breaker. Until is the end of the cooldown,
+	// or while a probe is in flight the end of the cooldown that follows should it fail.
+	CircuitOpenError struct {
+		Until time.Time
+	}
+)
+
+func NewBreaker(config BreakerConfig) *Breaker {
+	if config.FailureRatio <= 0 {
+		config.FailureRatio = defaultFailureRatio
+	}
+
+	if config.MinRequests <= 0 {
+		config.MinRequests = defaultMinRequests
+	}
+
+	if config.Window <= 0 {
+		config.Window = defaultWindow
+	}
+
+	if config.Cooldown <= 0 {
+		config.Cooldown = defaultCooldown
+	}
+
+	breaker := new(Breaker)
+	breaker.config = config
+	breaker.state = BreakerClosed
+	breaker.windowStart = time.Now()
+
+	return breaker
+}
+
+func (e *CircuitOpenError) Error() string {
+	return "circuit breaker is open until " + e.Until.Format(time.RFC3339)
+}
+
+// State returns the current state, moving an open breaker to half-open once its cooldown is over.
+func (b *Breaker) State() BreakerState {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.advance(time.Now())
+
+	return b.state
+}
+
+func (b *Breaker) allow() (ticket, *CircuitOpenError) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	admitted := ticket{generation: b.generation, probe: false}
+
+	b.advance(now)
+
+	switch {
+	case b.state == BreakerOpen:
+		return admitted, &CircuitOpenError{Until: b.openedAt.Add(b.config.Cooldown)}
+	case b.state == BreakerHalfOpen && b.probing:
+		return admitted, &CircuitOpenError{Until: now.Add(b.config.Cooldown)}
+	case b.state == BreakerHalfOpen:
+		b.probing = true
+		admitted.probe = true
+	}
+
+	return admitted, nil
+}
+
+// record counts the outcome of a call. Calls let through before the breaker last opened are ignored, only the probe
+// decides the half-open state.
+func (b *Breaker) record(ctx context.Context, admitted ticket, call *Call, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if admitted.generation != b.generation {
+		return
+	}
+
+	now := time.Now()
+	cancelled := ctx.Err() != nil
+	failed := !cancelled && (call.Status == 0 && err != nil ||
+		call.Status == http.StatusTooManyRequests ||
+		call.Status >= http.StatusInternalServerError)
+
+	if b.state == BreakerHalfOpen {
+		if !admitted.probe {
+			return
+		}
+
+		b.probing = false
+
+		switch {
+		case cancelled:
+		case failed:
+			b.trip(now)
+		default:
+			b.reset(now, BreakerClosed)
+		}
+
+		return
+	}
+
+	b.advance(now)
+
+	if cancelled || b.state != BreakerClosed {
+		return
+	}
+
+	b.total++
+
+	if failed {
+		b.failures++
+	}
+
+	if b.total >= b.config.MinRequests && float64(b.failures)/float64(b.total) >= b.config.FailureRatio {
+		b.trip(now)
+	}
+}
+
+func (b *Breaker) advance(now time.Time) {
+	switch {
+	case b.state == BreakerOpen && now.Sub(b.openedAt) >= b.config.Cooldown:
+		b.state = BreakerHalfOpen
+	case b.state == BreakerClosed && now.Sub(b.windowStart) >= b.config.Window:
+		b.reset(now, BreakerClosed)
+	}
+}
+
+func (b *Breaker) trip(now time.Time) {
+	b.reset(now, BreakerOpen)
+	b.openedAt = now
+	b.generation++
+}
+
+func (b *Breaker) reset(now time.Time, state BreakerState) {
+	b.state = state
+	b.failures = 0
+	b.total = 0
+	b.windowStart = now
+}
+
+func breaking(breaker *Breaker) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, call *Call) error {
+			admitted, rejected := breaker.allow()
+			if rejected != nil {
+				return oops.In(service).Code(errCircuitOpen).
+					With("until", rejected.Until).
+					Public("TMDB is unavailable right now, try again later.").
+					Wrap(rejected)
+			}
+
+			err := next.Do(ctx, call)
+
+			breaker.record(ctx, admitted, call, err)
+
+			return err
+		})
+	}
+}
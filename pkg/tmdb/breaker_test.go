@@ -0,0 +1,206 @@
+package tmdb_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
+)
+
+const breakerCooldown = 200 * time.Millisecond
+
+func breakerClient(t *testing.T, faults int) (*tmdb.TMDB, *tmdb.Breaker, *tmdbtest.Server) {
+	t.Helper()
+
+	server := fixture(t)
+	if faults > 0 {
+		server.Script(tmdbtest.Fault{Path: "", Status: http.StatusServiceUnavailable, Delay: 0, Times: faults})
+	}
+
+	breaker := tmdb.NewBreaker(tmdb.BreakerConfig{
+		FailureRatio: 0.5,
+		MinRequests:  4,
+		Window:       time.Minute,
+		Cooldown:     breakerCooldown,
+	})
+
+	config := server.Config()
+	config.Breaker = breaker
+
+	return fp.Must(tmdb.New(config)), breaker, server
+}
+
+func TestTMDBBreakerOpens(t *testing.T) {
+	t.Parallel()
+
+	obj, breaker, server := breakerClient(t, 2)
+
+	for page := range 4 {
+		_, _ = obj.GetPopularMovies(t.Context(), page+1)
+	}
+
+	assert.Equal(t, tmdb.BreakerOpen, breaker.State())
+	assert.Equal(t, 4, server.Hits("/3/movie/popular"))
+
+	_, err := obj.GetPopularMovies(t.Context(), 1)
+
+	var (
+		orr     oops.OopsError
+		rejects *tmdb.CircuitOpenError
+	)
+
+	require.ErrorAs(t, err, &orr)
+	require.ErrorAs(t, err, &rejects)
+	assert.Equal(t, "circuitOpen", orr.Code())
+	assert.Equal(t, "TMDB is unavailable right now, try again later.", orr.Public())
+	assert.WithinDuration(t, time.Now().Add(breakerCooldown), rejects.Until, breakerCooldown)
+	assert.Equal(t, 4, server.Hits("/3/movie/popular"))
+}
+
+func TestTMDBBreakerRecovers(t *testing.T) {
+	t.Parallel()
+
+	obj, breaker, server := breakerClient(t, 4)
+
+	for page := range 4 {
+		_, _ = obj.GetPopularMovies(t.Context(), page+1)
+	}
+
+	require.Equal(t, tmdb.BreakerOpen, breaker.State())
+
+	time.Sleep(breakerCooldown)
+
+	assert.Equal(t, tmdb.BreakerHalfOpen, breaker.State())
+
+	got, err := obj.GetPopularMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, popular(), got)
+	assert.Equal(t, tmdb.BreakerClosed, breaker.State())
+	assert.Equal(t, 5, server.Hits("/3/movie/popular"))
+}
+
+func TestTMDBBreakerProbeFails(t *testing.T) {
+	t.Parallel()
+
+	obj, breaker, server := breakerClient(t, 5)
+
+	for page := range 4 {
+		_, _ = obj.GetPopularMovies(t.Context(), page+1)
+	}
+
+	time.Sleep(breakerCooldown)
+
+	_, err := obj.GetPopularMovies(t.Context(), 1)
+
+	require.Error(t, err)
+	assert.Equal(t, tmdb.BreakerOpen, breaker.State())
+
+	_, err = obj.GetPopularMovies(t.Context(), 1)
+
+	var rejects *tmdb.CircuitOpenError
+
+	require.ErrorAs(t, err, &rejects)
+	assert.Equal(t, 5, server.Hits("/3/movie/popular"))
+}
+
+func TestTMDBBreakerIgnoresCallsFromBeforeOpening(t *testing.T) {
+	t.Parallel()
+
+	slow := 2 * breakerCooldown
+	obj, breaker, server := breakerClient(t, 0)
+	server.Script(
+		tmdbtest.Fault{Path: "/3/movie/top_rated", Status: 0, Delay: slow, Times: 1},
+		tmdbtest.Fault{Path: "/3/movie/popular", Status: http.StatusServiceUnavailable, Delay: 0, Times: 4},
+		tmdbtest.Fault{Path: "/3/movie/upcoming", Status: http.StatusServiceUnavailable, Delay: slow, Times: 1},
+	)
+
+	stale, probe := make(chan error), make(chan error)
+
+	go func() { _, err := obj.GetTopRatedMovies(t.Context(), 1); stale <- err }()
+
+	for page := range 4 {
+		_, _ = obj.GetPopularMovies(t.Context(), page+1)
+	}
+
+	require.Equal(t, tmdb.BreakerOpen, breaker.State())
+
+	time.Sleep(breakerCooldown)
+
+	go func() { _, err := obj.GetUpcomingMovies(t.Context(), 1); probe <- err }()
+
+	// the call in flight since before the breaker opened succeeds while the probe is still out
+	require.NoError(t, <-stale)
+	assert.Equal(t, tmdb.BreakerHalfOpen, breaker.State())
+
+	_, err := obj.GetPopularMovies(t.Context(), 1)
+
+	var rejects *tmdb.CircuitOpenError
+
+	require.ErrorAs(t, err, &rejects)
+	assert.True(t, rejects.Until.After(time.Now()))
+
+	require.Error(t, <-probe)
+	assert.Equal(t, tmdb.BreakerOpen, breaker.State())
+}
+
+func TestTMDBBreakerIgnoresCancelledAndClientErrors(t *testing.T) {
+	t.Parallel()
+
+	obj, breaker, _ := breakerClient(t, 0)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	for range 4 {
+		_, err := obj.GetPopularMovies(ctx, 1)
+		require.ErrorIs(t, err, context.Canceled)
+
+		_, err = obj.GetPopularMovies(t.Context(), 501)
+		require.Error(t, err)
+	}
+
+	assert.Equal(t, tmdb.BreakerClosed, breaker.State())
+}
+
+func TestTMDBBreakerIgnoresInvalidLocale(t *testing.T) {
+	t.Parallel()
+
+	_, breaker, server := breakerClient(t, 0)
+
+	config := withLocale(server, "zz", "")
+	config.Breaker = breaker
+	obj := fp.Must(tmdb.New(config))
+
+	for range 4 {
+		assertPublic(t, obj.CheckLocale(t.Context()), `Invalid language "zz": TMDB does not know it.`)
+	}
+
+	assert.Equal(t, tmdb.BreakerClosed, breaker.State())
+}
+
+func TestPrometheusMetricsWatchBreaker(t *testing.T) {
+	t.Parallel()
+
+	breaker := tmdb.NewBreaker(tmdb.BreakerConfig{FailureRatio: 0, MinRequests: 0, Window: 0, Cooldown: 0})
+	sink := tmdb.NewPrometheusMetrics().WatchBreaker(breaker)
+
+	output := new(strings.Builder)
+	_, err := sink.WriteTo(output)
+
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), `# TYPE tmdb_circuit_state gauge
+tmdb_circuit_state{state="closed"} 1
+tmdb_circuit_state{state="half-open"} 0
+tmdb_circuit_state{state="open"} 0
+`)
+}
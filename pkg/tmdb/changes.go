@@ -0,0 +1,106 @@
+package tmdb
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+const (
+	pathMovieChanges  = "/3/movie/changes"
+	pathTVChanges     = "/3/tv/changes"
+	pathPersonChanges = "/3/person/changes"
+	pathTV            = "/3/tv/"
+	pathPerson        = "/3/person/"
+	pathChanges       = "/changes"
+
+	// ChangesWindow is the longest date range TMDB serves changes for in one call.
+	ChangesWindow = 14 * 24 * time.Hour
+
+	dateLayout = "2006-01-02"
+)
+
+// ChangesOptions select the days to report changes for. TMDB defaults to the last 24 hours when both dates are zero.
+// Only the dates matter, the range spans at most ChangesWindow. Page is ignored by the per-item changes.
+type ChangesOptions struct {
+	Start time.Time
+	End   time.Time
+	Page  int
+}
+
+func (c *TMDB) GetChangedMovies(ctx context.Context, opts ChangesOptions) (Page[ChangedItem], error) {
+	return c.changed(ctx, "GetChangedMovies", pathMovieChanges, opts)
+}
+
+func (c *TMDB) GetChangedTV(ctx context.Context, opts ChangesOptions) (Page[ChangedItem], error) {
+	return c.changed(ctx, "GetChangedTV", pathTVChanges, opts)
+}
+
+func (c *TMDB) GetChangedPeople(ctx context.Context, opts ChangesOptions) (Page[ChangedItem], error) {
+	return c.changed(ctx, "GetChangedPeople", pathPersonChanges, opts)
+}
+
+// GetMovieChanges tells what changed on the movie, grouped by field.
+func (c *TMDB) GetMovieChanges(ctx context.Context, movieID int, opts ChangesOptions) ([]Change, error) {
+	return c.changes(ctx, "GetMovieChanges", pathMovie+strconv.Itoa(movieID)+pathChanges, opts)
+}
+
+func (c *TMDB) GetTVChanges(ctx context.Context, seriesID int, opts ChangesOptions) ([]Change, error) {
+	return c.changes(ctx, "GetTVChanges", pathTV+strconv.Itoa(seriesID)+pathChanges, opts)
+}
+
+func (c *TMDB) GetPersonChanges(ctx context.Context, personID int, opts ChangesOptions) ([]Change, error) {
+	return c.changes(ctx, "GetPersonChanges", pathPerson+strconv.Itoa(personID)+pathChanges, opts)
+}
+
+func (c *TMDB) changed(ctx context.Context, operation, path string, opts ChangesOptions) (Page[ChangedItem], error) {
+	var data Page[ChangedItem]
+
+	params, err := c.dateRange(opts)
+	if err != nil {
+		return data, err
+	}
+
+	params["page"] = strconv.Itoa(max(opts.Page, 1))
+
+	return data, c.get(ctx, operation, path, params, &data)
+}
+
+func (c *TMDB) changes(ctx context.Context, operation, path string, opts ChangesOptions) ([]Change, error) {
+	var data struct {
+		Changes []Change `json:"changes"`
+	}
+
+	params, err := c.dateRange(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Changes, c.get(ctx, operation, path, params, &data)
+}
+
+func (c *TMDB) dateRange(opts ChangesOptions) (map[string]string, error) {
+	params := make(map[string]string)
+
+	if !opts.Start.IsZero() {
+		params["start_date"] = opts.Start.UTC().Format(dateLayout)
+	}
+
+	if !opts.End.IsZero() {
+		params["end_date"] = opts.End.UTC().Format(dateLayout)
+	}
+
+	if opts.Start.IsZero() || opts.End.IsZero() {
+		return params, nil
+	}
+
+	if opts.End.Before(opts.Start) || opts.End.Sub(opts.Start) > ChangesWindow {
+		return nil, c.oops.Code(errInvalidArgument).
+			With("start", params["start_date"]).
+			With("end", params["end_date"]).
+			Public("Invalid date range: Changes span at most 14 days and end after they start.").
+			New("invalid date range")
+	}
+
+	return params, nil
+}
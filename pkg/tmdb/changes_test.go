@@ -0,0 +1,91 @@
+package tmdb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func TestTMDBGetChanged(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	obj := fp.Must(tmdb.New(fixture(t).Config()))
+	latest := tmdb.ChangesOptions{Start: time.Time{}, End: time.Time{}, Page: 0}
+
+	movies, err := obj.GetChangedMovies(t.Context(), latest)
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.ChangedItem{{ID: 550, Adult: false}}, movies.Results)
+
+	twoWeeks := tmdb.ChangesOptions{Start: now.AddDate(0, 0, -14), End: now, Page: 1}
+
+	movies, err = obj.GetChangedMovies(t.Context(), twoWeeks)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, movies.TotalResults)
+
+	shows, err := obj.GetChangedTV(t.Context(), latest)
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.ChangedItem{{ID: 1396, Adult: false}}, shows.Results)
+
+	people, err := obj.GetChangedPeople(t.Context(), latest)
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.ChangedItem{{ID: 287, Adult: false}}, people.Results)
+}
+
+func TestTMDBGetItemChanges(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	obj := fp.Must(tmdb.New(fixture(t).Config()))
+	twoWeeks := tmdb.ChangesOptions{Start: now.AddDate(0, 0, -14), End: now, Page: 0}
+
+	changes, err := obj.GetMovieChanges(t.Context(), 13, twoWeeks)
+
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "overview", changes[0].Key)
+	assert.JSONEq(t, `"Life is..."`, string(changes[0].Items[0].Value))
+
+	changes, err = obj.GetMovieChanges(t.Context(), 13, tmdb.ChangesOptions{Start: now, End: now, Page: 0})
+
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	changes, err = obj.GetTVChanges(t.Context(), 1396, twoWeeks)
+
+	require.NoError(t, err)
+	assert.Equal(t, "name", changes[0].Key)
+
+	changes, err = obj.GetPersonChanges(t.Context(), 287, twoWeeks)
+
+	require.NoError(t, err)
+	assert.Equal(t, "biography", changes[0].Key)
+}
+
+func TestTMDBChangesInvalidRange(t *testing.T) {
+	t.Parallel()
+
+	const invalid = "Invalid date range: Changes span at most 14 days and end after they start."
+
+	now := time.Now()
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	_, err := obj.GetChangedMovies(t.Context(), tmdb.ChangesOptions{Start: now.AddDate(0, 0, -15), End: now, Page: 1})
+	assertPublic(t, err, invalid)
+
+	_, err = obj.GetMovieChanges(t.Context(), 550, tmdb.ChangesOptions{Start: now, End: now.AddDate(0, 0, -1), Page: 0})
+	assertPublic(t, err, invalid)
+
+	assert.Zero(t, server.Hits("/3/movie/changes"))
+	assert.Zero(t, server.Hits("/3/movie/550/changes"))
+}
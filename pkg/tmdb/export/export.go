@@ -0,0 +1,196 @@
+// Package export streams the daily ID export files TMDB publishes for bulk jobs: gzipped NDJSON, one record per line.
+// The files run into the millions of lines, so they are read one line at a time and never held in memory.
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"iter"
+	"os"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+const (
+	service = "export.Read"
+
+	errExport = "exportError"
+
+	// Host serves the export files, available from about 8:00 UTC for the day before.
+	Host = "http://files.tmdb.org/p/exports/"
+
+	KindMovie      Kind = "movie_ids"
+	KindTV         Kind = "tv_series_ids"
+	KindPerson     Kind = "person_ids"
+	KindCollection Kind = "collection_ids"
+	KindKeyword    Kind = "keyword_ids"
+	KindNetwork    Kind = "tv_network_ids"
+	KindCompany    Kind = "production_company_ids"
+
+	dateLayout = "01_02_2006"
+
+	// maxLine bounds a single record, far above the longest one in the files.
+	maxLine = 1 << 20
+
+	gzipMagic = "\x1f\x8b"
+)
+
+type (
+	// Kind names an export file.
+	Kind string
+
+	// Record is one line of any export file, the fields missing from its kind left zero. Name is the original title
+	// of movies, the original name of shows and the name of everything else.
+	Record struct {
+		Name       string  `json:"name"`
+		Popularity float64 `json:"popularity"`
+		ID         int     `json:"id"`
+		Adult      bool    `json:"adult"`
+		Video      bool    `json:"video"`
+	}
+
+	// Filter drops the records not worth a bulk job. The zero Filter keeps them all.
+	Filter struct {
+		MinPopularity float64
+		SkipAdult     bool
+		SkipVideo     bool
+	}
+
+	record struct {
+		OriginalTitle string `json:"original_title"`
+		OriginalName  string `json:"original_name"`
+		Record
+	}
+)
+
+// URL returns where the kind export of day is published.
+func URL(kind Kind, day time.Time) string {
+	return Host + string(kind) + "_" + day.UTC().Format(dateLayout) + ".json.gz"
+}
+
+// Keep reports whether the record passes the filter.
+func (f Filter) Keep(rec Record) bool {
+	switch {
+	case rec.Popularity < f.MinPopularity:
+		return false
+	case f.SkipAdult && rec.Adult:
+		return false
+	case f.SkipVideo && rec.Video:
+		return false
+	default:
+		return true
+	}
+}
+
+// Read yields the records of reader the filter keeps, gunzipping it when needed. An invalid line yields its error
+// and reading goes on, a broken stream yields its error and ends.
+func Read(reader io.Reader, filter Filter) iter.Seq2[Record, error] {
+	return func(yield func(Record, error) bool) {
+		var empty Record
+
+		lines, err := scanner(reader)
+		if err != nil {
+			yield(empty, err)
+
+			return
+		}
+
+		for line := 1; lines.Scan(); line++ {
+			data := bytes.TrimSpace(lines.Bytes())
+			if len(data) == 0 {
+				continue
+			}
+
+			rec, err := parse(data, line)
+			if err != nil {
+				if !yield(empty, err) {
+					return
+				}
+
+				continue
+			}
+
+			if filter.Keep(rec) && !yield(rec, nil) {
+				return
+			}
+		}
+
+		if err = lines.Err(); err != nil {
+			yield(empty, readError(err))
+		}
+	}
+}
+
+// ReadFile is Read over the file at path, closed once the iteration ends.
+func ReadFile(path string, filter Filter) iter.Seq2[Record, error] {
+	return func(yield func(Record, error) bool) {
+		file, err := os.Open(path)
+		if err != nil {
+			var empty Record
+
+			yield(empty, readError(err))
+
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		for rec, err := range Read(file, filter) {
+			if !yield(rec, err) {
+				return
+			}
+		}
+	}
+}
+
+// scanner reads reader line by line, through gzip when it starts with the gzip magic bytes.
+func scanner(reader io.Reader) (*bufio.Scanner, error) {
+	buffered := bufio.NewReader(reader)
+
+	var source io.Reader = buffered
+
+	magic, _ := buffered.Peek(len(gzipMagic))
+	if string(magic) == gzipMagic {
+		unzipped, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, readError(err)
+		}
+
+		source = unzipped
+	}
+
+	lines := bufio.NewScanner(source)
+	lines.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLine)
+
+	return lines, nil
+}
+
+func parse(data []byte, line int) (Record, error) {
+	var rec record
+
+	err := json.Unmarshal(data, &rec)
+	if err != nil {
+		return rec.Record, oops.In(service).
+			Code(errExport).
+			With("line", line).
+			Public("Invalid export record: Each line is expected to be a JSON object.").
+			Wrap(err)
+	}
+
+	switch {
+	case rec.OriginalTitle != "":
+		rec.Name = rec.OriginalTitle
+	case rec.OriginalName != "":
+		rec.Name = rec.OriginalName
+	}
+
+	return rec.Record, nil
+}
+
+func readError(err error) error {
+	return oops.In(service).Code(errExport).Public("Cannot read the export file.").Wrap(err)
+}
@@ -0,0 +1,247 @@
+package export_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/export"
+)
+
+const movies = `{"adult":false,"id":550,"original_title":"Fight Club","popularity":61.4,"video":false}
+{"adult":true,"id":1,"original_title":"Adult","popularity":3.2,"video":false}
+
+{"adult":false,"id":2,"original_title":"Trailer","popularity":0.6,"video":true}
+{"adult":false,"id":13,"original_title":"Forrest Gump","popularity":48.3,"video":false}
+`
+
+func gzipped(t *testing.T, data string) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	writer := gzip.NewWriter(buf)
+
+	_, err := writer.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func collect(t *testing.T, records func(func(export.Record, error) bool)) []int {
+	t.Helper()
+
+	ids := make([]int, 0)
+
+	for rec, err := range records {
+		require.NoError(t, err)
+
+		ids = append(ids, rec.ID)
+	}
+
+	return ids
+}
+
+func TestURL(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "http://files.tmdb.org/p/exports/movie_ids_10_19_2026.json.gz", export.URL(export.KindMovie, day))
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		filter export.Filter
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "everything",
+			args: args{filter: export.Filter{MinPopularity: 0, SkipAdult: false, SkipVideo: false}},
+			want: []int{550, 1, 2, 13},
+		},
+		{
+			name: "no adult",
+			args: args{filter: export.Filter{MinPopularity: 0, SkipAdult: true, SkipVideo: false}},
+			want: []int{550, 2, 13},
+		},
+		{
+			name: "no video",
+			args: args{filter: export.Filter{MinPopularity: 0, SkipAdult: false, SkipVideo: true}},
+			want: []int{550, 1, 13},
+		},
+		{
+			name: "popular",
+			args: args{filter: export.Filter{MinPopularity: 50, SkipAdult: false, SkipVideo: false}},
+			want: []int{550},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, collect(t, export.Read(strings.NewReader(movies), test.args.filter)))
+			assert.Equal(t, test.want, collect(t, export.Read(bytes.NewReader(gzipped(t, movies)), test.args.filter)))
+		})
+	}
+}
+
+func TestReadNames(t *testing.T) {
+	t.Parallel()
+
+	data := `{"id":550,"original_title":"Fight Club","popularity":61.4}
+{"id":1396,"original_name":"Breaking Bad","popularity":120.5}
+{"adult":false,"id":287,"name":"Brad Pitt","popularity":20.1}
+{"id":10,"name":"Star Wars Collection"}
+`
+
+	names := make([]string, 0)
+
+	for rec, err := range export.Read(strings.NewReader(data), *new(export.Filter)) {
+		require.NoError(t, err)
+
+		names = append(names, rec.Name)
+	}
+
+	assert.Equal(t, []string{"Fight Club", "Breaking Bad", "Brad Pitt", "Star Wars Collection"}, names)
+}
+
+func TestReadFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "movie_ids_10_19_2026.json.gz")
+
+	require.NoError(t, os.WriteFile(path, gzipped(t, movies), 0o600))
+
+	filter := export.Filter{MinPopularity: 1, SkipAdult: true, SkipVideo: true}
+
+	assert.Equal(t, []int{550, 13}, collect(t, export.ReadFile(path, filter)))
+}
+
+func TestReadStop(t *testing.T) {
+	t.Parallel()
+
+	ids := make([]int, 0)
+
+	for rec := range export.Read(strings.NewReader(movies), *new(export.Filter)) {
+		ids = append(ids, rec.ID)
+
+		if len(ids) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{550, 1}, ids)
+}
+
+func TestReadStream(t *testing.T) {
+	t.Parallel()
+
+	const lines = 100_000
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		for id := 1; id <= lines; id++ {
+			_, _ = fmt.Fprintf(writer, `{"id":%d,"original_title":"Movie %d","popularity":1}`+"\n", id, id)
+		}
+
+		_ = writer.Close()
+	}()
+
+	count := 0
+
+	for rec, err := range export.Read(reader, *new(export.Filter)) {
+		require.NoError(t, err)
+
+		count++
+
+		assert.Equal(t, count, rec.ID)
+	}
+
+	assert.Equal(t, lines, count)
+}
+
+func TestReadInvalidLine(t *testing.T) {
+	t.Parallel()
+
+	var orr oops.OopsError
+
+	data := `{"id":550,"original_title":"Fight Club"}
+not json
+{"id":13,"original_title":"Forrest Gump"}
+`
+	ids := make([]int, 0)
+	errs := make([]error, 0)
+
+	for rec, err := range export.Read(strings.NewReader(data), *new(export.Filter)) {
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		ids = append(ids, rec.ID)
+	}
+
+	assert.Equal(t, []int{550, 13}, ids)
+	require.Len(t, errs, 1)
+	require.ErrorAs(t, errs[0], &orr)
+	assert.Equal(t, "Invalid export record: Each line is expected to be a JSON object.", orr.Public())
+	assert.Equal(t, 2, orr.Context()["line"])
+}
+
+func TestReadFailure(t *testing.T) {
+	t.Parallel()
+
+	all := *new(export.Filter)
+	missing := filepath.Join(t.TempDir(), "missing.json.gz")
+	truncated := gzipped(t, movies)
+	truncated = truncated[:len(truncated)-10]
+
+	tests := []struct {
+		records func(func(export.Record, error) bool)
+		name    string
+	}{
+		{name: "missing file", records: export.ReadFile(missing, all)},
+		{name: "truncated gzip", records: export.Read(bytes.NewReader(truncated), all)},
+		{name: "bad gzip header", records: export.Read(strings.NewReader("\x1f\x8bjunk"), all)},
+		{name: "line too long", records: export.Read(strings.NewReader(strings.Repeat("x", 2<<20)), all)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				orr  oops.OopsError
+				last error
+			)
+
+			for _, err := range test.records {
+				last = err
+			}
+
+			require.ErrorAs(t, last, &orr)
+			assert.Equal(t, "Cannot read the export file.", orr.Public())
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package tmdb_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
+)
+
+// fixture starts the fake TMDB the client tests share and closes it with the test. It serves two popular movies,
+// Fight Club with its titles, translations and posters, Breaking Bad with its first season and cast, Bryan Cranston,
+// Brad Pitt's profile and changes made to them an hour or ten days ago.
+func fixture(t *testing.T) *tmdbtest.Server {
+	t.Helper()
+
+	now := time.Now()
+	show := breakingBad()
+
+	server := tmdbtest.NewServer().
+		SeedList(tmdbtest.ListPopular, popular()...).
+		SeedDetails(fightClub()).
+		SeedTV(show.TVShow).
+		SeedShow(show, firstSeason()).
+		SeedAggregateCredits(show.ID, tmdb.AggregateCredits{Cast: []tmdb.AggregateCast{{
+			Name:              "Bryan Cranston",
+			Roles:             []tmdb.Role{{Character: "Walter White", EpisodeCount: 62}},
+			ID:                17419,
+			Order:             0,
+			TotalEpisodeCount: 62,
+		}}, Crew: nil}).
+		SeedPeople(cranston()).
+		SeedProfiles(287, poster("/profile.jpg", "", 5.4, 8, 500)).
+		SeedChanges(tmdbtest.KindMovie, 550, change("title", now.Add(-time.Hour), `"Fight Club"`)).
+		SeedChanges(tmdbtest.KindMovie, 13, change("overview", now.Add(-10*24*time.Hour), `"Life is..."`)).
+		SeedChanges(tmdbtest.KindTV, show.ID, change("name", now.Add(-time.Hour), `"Breaking Bad"`)).
+		SeedChanges(tmdbtest.KindPerson, 287, change("biography", now.Add(-time.Hour), `"Brad"`))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func loggedIn(t *testing.T, server *tmdbtest.Server) tmdb.Config {
+	t.Helper()
+
+	obj := fp.Must(tmdb.New(server.Config()))
+	token := fp.Must(obj.CreateRequestToken(t.Context()))
+
+	server.Approve(token.RequestToken)
+
+	config := server.Config()
+	config.SessionID = fp.Must(obj.CreateSession(t.Context(), token.RequestToken)).SessionID
+
+	return config
+}
+
+func withAccess(t *testing.T, server *tmdbtest.Server) tmdb.Config {
+	t.Helper()
+
+	obj := fp.Must(tmdb.New(server.Config()))
+	request := fp.Must(obj.CreateAccessRequest(t.Context()))
+
+	server.Approve(request.RequestToken)
+
+	config := server.Config()
+	config.AccessToken = fp.Must(obj.CreateAccessToken(t.Context(), request.RequestToken)).AccessToken
+
+	return config
+}
+
+func withLocale(server *tmdbtest.Server, language, region string) tmdb.Config {
+	config := server.Config()
+	config.Language = language
+	config.Region = region
+
+	return config
+}
+
+func popular() []tmdb.Movie {
+	return []tmdb.Movie{newMovie(13, "Forrest Gump"), newMovie(1, "Bad Boys")}
+}
+
+func newMovie(id int, title string) tmdb.Movie {
+	return tmdb.Movie{Title: title, Overview: "", ReleaseDate: "", Popularity: 0, ID: id, VoteCount: 0}
+}
+
+func newShow(id int, name string) tmdb.TVShow {
+	return tmdb.TVShow{Name: name, Overview: "", FirstAirDate: "", Popularity: 0, ID: id, VoteCount: 0}
+}
+
+func fightClub() tmdb.MovieDetails {
+	return tmdb.MovieDetails{
+		Credits: nil,
+		Videos:  nil,
+		Images: &tmdb.Images{
+			Backdrops: []tmdb.Image{poster("/backdrop.jpg", "", 5, 10, 1920)},
+			Logos:     nil,
+			Posters: []tmdb.Image{
+				poster("/en.jpg", "en", 5.5, 20, 1000),
+				poster("/de.jpg", "de", 5.2, 4, 1000),
+				poster("/fr.jpg", "fr", 5.3, 6, 1000),
+			},
+		},
+		Keywords:        nil,
+		ReleaseDates:    nil,
+		ExternalIDs:     nil,
+		Recommendations: nil,
+		Similar:         nil,
+		AltTitles: &tmdb.AltTitles{Titles: []tmdb.AltTitle{
+			{Country: "ES", Title: "El club de la lucha", Type: ""},
+			{Country: "MX", Title: "El club de la pelea", Type: ""},
+			{Country: "US", Title: "Project Mayhem", Type: "working title"},
+		}},
+		Translations: &tmdb.Translations{Translations: []tmdb.Translation{
+			translation("de", "DE", "", "Ein Yuppie findet Gefallen an Untergrund-Kämpfen."),
+			translation("es", "ES", "El club de la lucha", "Un joven sin ilusiones lucha contra su insomnio."),
+			translation("es", "MX", "El club de la pelea", "Un empleado de oficina insomne."),
+		}},
+		Tagline:     "",
+		Status:      "",
+		IMDbID:      "",
+		Genres:      nil,
+		Movie:       newMovie(550, "Fight Club"),
+		VoteAverage: 0,
+		Budget:      0,
+		Revenue:     0,
+		Runtime:     0,
+	}
+}
+
+func breakingBad() tmdb.TVShowDetails {
+	return tmdb.TVShowDetails{
+		Tagline:     "",
+		Status:      "",
+		LastAirDate: "",
+		Genres:      nil,
+		Networks:    nil,
+		Seasons: []tmdb.SeasonSummary{
+			{Name: "Season 1", Overview: "", AirDate: "2008-01-20", ID: 3572, SeasonNumber: 1, EpisodeCount: 2},
+		},
+		EpisodeRunTime:   nil,
+		TVShow:           newShow(1396, "Breaking Bad"),
+		VoteAverage:      0,
+		NumberOfSeasons:  1,
+		NumberOfEpisodes: 0,
+		InProduction:     false,
+	}
+}
+
+func firstSeason() tmdb.Season {
+	return tmdb.Season{
+		Name:     "Season 1",
+		Overview: "",
+		AirDate:  "",
+		Episodes: []tmdb.Episode{
+			newEpisode(1, 1, "Pilot", "2008-01-20", 58),
+			newEpisode(1, 2, "Cat's in the Bag...", "2008-01-27", 48),
+		},
+		ID:           3572,
+		SeasonNumber: 1,
+	}
+}
+
+func newEpisode(season, number int, name, airDate string, runtime int) tmdb.Episode {
+	return tmdb.Episode{
+		Name:          name,
+		Overview:      "",
+		AirDate:       airDate,
+		Crew:          nil,
+		GuestStars:    nil,
+		VoteAverage:   0,
+		ID:            0,
+		ShowID:        1396,
+		SeasonNumber:  season,
+		EpisodeNumber: number,
+		Runtime:       runtime,
+		VoteCount:     0,
+	}
+}
+
+func cranston() tmdb.Person {
+	show := newShow(1396, "Breaking Bad")
+
+	return tmdb.Person{
+		Name:               "Bryan Cranston",
+		KnownForDepartment: "Acting",
+		KnownFor:           []tmdb.SearchResult{{Movie: nil, TVShow: &show, Person: nil, MediaType: tmdb.MediaTV}},
+		Popularity:         0,
+		ID:                 17419,
+		Adult:              false,
+	}
+}
+
+func translation(language, country, title, overview string) tmdb.Translation {
+	return tmdb.Translation{
+		Country:     country,
+		Language:    language,
+		Name:        "",
+		EnglishName: "",
+		Data:        tmdb.TranslationData{Title: title, Overview: overview, Tagline: "", Homepage: "", Runtime: 0},
+	}
+}
+
+func poster(path, language string, average float64, votes, width int) tmdb.Image {
+	return tmdb.Image{
+		FilePath:    path,
+		Language:    language,
+		AspectRatio: 0.667,
+		VoteAverage: average,
+		VoteCount:   votes,
+		Width:       width,
+		Height:      width * 3 / 2,
+	}
+}
+
+func change(key string, at time.Time, value string) tmdb.Change {
+	return tmdb.Change{Key: key, Items: []tmdb.ChangeItem{{
+		ID:            "change-" + key,
+		Action:        "updated",
+		Time:          at.UTC().Format(tmdbtest.ChangeTime),
+		Language:      "en",
+		Country:       "",
+		Value:         json.RawMessage(value),
+		OriginalValue: nil,
+	}}}
+}
@@ -0,0 +1,121 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+type (
+	reply struct {
+		header  http.Header
+		body    []byte
+		status  int
+		attempt int
+	}
+
+	// flight is one HTTP request shared by every identical call made while it is in progress. It runs detached from
+	// the callers' contexts and is cancelled only when the last waiting caller gives up.
+	flight struct {
+		err     error
+		done    chan struct{}
+		cancel  context.CancelFunc
+		reply   reply
+		waiters int
+	}
+)
+
+// share runs call, or joins an identical one in flight, which it reports.
+func (c *TMDB) share(ctx context.Context, call *Call) (reply, bool, error) {
+	if call.Method != http.MethodGet {
+		data, err := c.execute(ctx, call)
+
+		return data, false, err
+	}
+
+	key := flightKey(call)
+
+	c.mutex.Lock()
+
+	shared, joined := c.flights[key]
+	if !joined {
+		shared = c.takeoff(ctx, key, call)
+	}
+
+	shared.waiters++
+
+	c.mutex.Unlock()
+
+	select {
+	case <-shared.done:
+		return shared.reply, joined, shared.err
+	case <-ctx.Done():
+		c.leave(key, shared)
+
+		return reply{header: nil, body: nil, status: 0, attempt: 0}, joined, c.fetchError(ctx.Err())
+	}
+}
+
+func (c *TMDB) takeoff(ctx context.Context, key string, call *Call) *flight {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+
+	shared := new(flight)
+	shared.done = make(chan struct{})
+	shared.cancel = cancel
+
+	c.flights[key] = shared
+
+	go func() {
+		defer cancel()
+
+		shared.reply, shared.err = c.execute(ctx, call)
+
+		c.mutex.Lock()
+
+		if c.flights[key] == shared {
+			delete(c.flights, key)
+		}
+
+		c.mutex.Unlock()
+		close(shared.done)
+	}()
+
+	return shared
+}
+
+func (c *TMDB) leave(key string, shared *flight) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	shared.waiters--
+
+	if shared.waiters == 0 {
+		shared.cancel()
+
+		if c.flights[key] == shared {
+			delete(c.flights, key)
+		}
+	}
+}
+
+// flightKey identifies identical calls by method, path, query and headers. Client-wide parameters such as `language`
+// are the same for every call, so they are part of the key implicitly.
+func flightKey(call *Call) string {
+	query := make(url.Values, len(call.Query))
+
+	for name, value := range call.Query {
+		query.Set(name, value)
+	}
+
+	headers := make([]string, 0, len(call.Header))
+
+	for name, values := range call.Header {
+		headers = append(headers, name+": "+strings.Join(values, ", "))
+	}
+
+	slices.Sort(headers)
+
+	return call.Method + " " + call.Path + "?" + query.Encode() + "\n" + strings.Join(headers, "\n")
+}
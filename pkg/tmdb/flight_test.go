@@ -0,0 +1,106 @@
+package tmdb_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
+)
+
+const flightDelay = 300 * time.Millisecond
+
+func TestTMDBCoalescesIdenticalCalls(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t).Script(tmdbtest.Fault{Path: "/3/movie/popular", Status: 0, Delay: flightDelay, Times: 0})
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	var group sync.WaitGroup
+
+	results := make([][]tmdb.Movie, 5)
+	errs := make([]error, 5)
+
+	for idx := range results {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			results[idx], errs[idx] = obj.GetPopularMovies(t.Context(), 1)
+		}()
+	}
+
+	group.Wait()
+
+	for idx := range results {
+		require.NoError(t, errs[idx])
+		assert.Equal(t, popular(), results[idx])
+	}
+
+	assert.Equal(t, 1, server.Hits("/3/movie/popular"))
+
+	_, err := obj.GetPopularMovies(t.Context(), 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, server.Hits("/3/movie/popular"))
+}
+
+func TestTMDBCoalescingCallerCancels(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t).Script(tmdbtest.Fault{Path: "/3/movie/popular", Status: 0, Delay: flightDelay, Times: 1})
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	ctx, cancel := context.WithTimeout(t.Context(), flightDelay/3)
+	defer cancel()
+
+	var (
+		group   sync.WaitGroup
+		patient []tmdb.Movie
+		errWait error
+	)
+
+	group.Add(1)
+
+	go func() {
+		defer group.Done()
+
+		patient, errWait = obj.GetPopularMovies(t.Context(), 1)
+	}()
+
+	_, errCancel := obj.GetPopularMovies(ctx, 1)
+
+	group.Wait()
+
+	require.ErrorIs(t, errCancel, context.DeadlineExceeded)
+	require.NoError(t, errWait)
+	assert.Equal(t, popular(), patient)
+	assert.Equal(t, 1, server.Hits("/3/movie/popular"))
+}
+
+func TestTMDBCoalescingAllCallersCancel(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t).Script(tmdbtest.Fault{Path: "/3/movie/popular", Status: 0, Delay: flightDelay, Times: 1})
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	ctx, cancel := context.WithTimeout(t.Context(), flightDelay/3)
+	defer cancel()
+
+	_, err := obj.GetPopularMovies(ctx, 1)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	got, err := obj.GetPopularMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, popular(), got)
+	assert.Equal(t, 2, server.Hits("/3/movie/popular"))
+}
@@ -0,0 +1,96 @@
+package tmdb
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	pathImages = "/images"
+
+	// ImageBaseURL serves the image files: append a size, such as "w500" or "original", and Image.FilePath.
+	ImageBaseURL = "https://image.tmdb.org/t/p/"
+
+	// imageNull is how TMDB names the language of artwork without text.
+	imageNull = "null"
+)
+
+type (
+	// ImageOptions narrow the images to Languages, e.g. "de" or "pt-BR" whose region is ignored as images only have
+	// a language. An empty language stands for the artwork without text. With no Languages TMDB returns the images
+	// in Config.Language only.
+	ImageOptions struct {
+		Languages []string
+	}
+
+	profilesResponse struct {
+		Profiles []Image `json:"profiles"`
+	}
+)
+
+func (c *TMDB) GetMovieImages(ctx context.Context, movieID int, opts ImageOptions) (Images, error) {
+	var data Images
+
+	params := make(map[string]string)
+
+	if len(opts.Languages) > 0 {
+		languages := make([]string, 0, len(opts.Languages))
+
+		for _, language := range opts.Languages {
+			// regions fold into their language, so "de-AT" and "de-CH" ask for "de" once
+			if language = cmp.Or(imageLanguage(language), imageNull); !slices.Contains(languages, language) {
+				languages = append(languages, language)
+			}
+		}
+
+		params["include_image_language"] = strings.Join(languages, ",")
+	}
+
+	return data, c.get(ctx, "GetMovieImages", pathMovie+strconv.Itoa(movieID)+pathImages, params, &data)
+}
+
+// GetPersonImages returns the profile pictures of a person. They have no language to filter on.
+func (c *TMDB) GetPersonImages(ctx context.Context, personID int) ([]Image, error) {
+	var data profilesResponse
+
+	path := pathPerson + strconv.Itoa(personID) + pathImages
+
+	return data.Profiles, c.get(ctx, "GetPersonImages", path, make(map[string]string), &data)
+}
+
+// BestPoster picks the poster in language, e.g. "de" or "pt-BR", falling back to the posters without text. The best
+// voted one wins, then the one with more votes, then the larger one. False when there is none to pick from.
+func (i Images) BestPoster(language string) (Image, bool) {
+	for _, wanted := range []string{imageLanguage(language), ""} {
+		posters := slices.DeleteFunc(slices.Clone(i.Posters), func(poster Image) bool {
+			return poster.Language != wanted
+		})
+
+		if len(posters) > 0 {
+			return slices.MaxFunc(posters, func(a, b Image) int {
+				return cmp.Or(
+					cmp.Compare(a.VoteAverage, b.VoteAverage),
+					cmp.Compare(a.VoteCount, b.VoteCount),
+					cmp.Compare(a.Width, b.Width),
+				)
+			}), true
+		}
+	}
+
+	return *new(Image), false
+}
+
+// URL is where the image file is served in size, e.g. "w500" or "original".
+func (i Image) URL(size string) string {
+	return ImageBaseURL + size + i.FilePath
+}
+
+// imageLanguage drops the region of a `language-REGION` tag.
+func imageLanguage(tag string) string {
+	language, _, _ := strings.Cut(tag, "-")
+
+	return language
+}
@@ -0,0 +1,140 @@
+package tmdb_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/mocks"
+)
+
+func TestTMDBGetMovieImages(t *testing.T) {
+	t.Parallel()
+
+	obj := fp.Must(tmdb.New(fixture(t).Config()))
+
+	images, err := obj.GetMovieImages(t.Context(), 550, tmdb.ImageOptions{Languages: []string{"de-AT", ""}})
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.Image{poster("/de.jpg", "de", 5.2, 4, 1000)}, images.Posters)
+	assert.Equal(t, []tmdb.Image{poster("/backdrop.jpg", "", 5, 10, 1920)}, images.Backdrops)
+	assert.Empty(t, images.Logos)
+
+	images, err = obj.GetMovieImages(t.Context(), 550, tmdb.ImageOptions{Languages: nil})
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.Image{poster("/en.jpg", "en", 5.5, 20, 1000)}, images.Posters)
+	assert.Empty(t, images.Backdrops)
+
+	_, err = obj.GetMovieImages(t.Context(), 404, tmdb.ImageOptions{Languages: nil})
+	assertPublic(t, err, "The resource you requested could not be found.")
+}
+
+func TestTMDBGetMovieImagesLanguages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		want      string
+		languages []string
+	}{
+		{name: "configured", languages: nil, want: ""},
+		{name: "regions", languages: []string{"de-AT", "de-CH", ""}, want: "de,null"},
+		{name: "repeated apart", languages: []string{"de", "en", "de", "", "en-GB"}, want: "de,en,null"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			trans := mocks.NewMockRoundTripper(t)
+			trans.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.Query().Get("include_image_language") == test.want
+			})).Return(response(t, http.StatusOK, `{"id": 550}`), nil)
+
+			obj := New().SetTransport(trans)
+			_, err := obj.GetMovieImages(t.Context(), 550, tmdb.ImageOptions{Languages: test.languages})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTMDBGetMovieDetailsImages(t *testing.T) {
+	t.Parallel()
+
+	obj := fp.Must(tmdb.New(withLocale(fixture(t), "de-DE", "")))
+
+	details, err := obj.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{Append: []tmdb.Append{tmdb.AppendImages}})
+
+	require.NoError(t, err)
+	require.NotNil(t, details.Images)
+	assert.Equal(t, []tmdb.Image{poster("/de.jpg", "de", 5.2, 4, 1000)}, details.Images.Posters)
+	assert.Equal(t, []tmdb.Image{poster("/backdrop.jpg", "", 5, 10, 1920)}, details.Images.Backdrops)
+}
+
+func TestTMDBGetPersonImages(t *testing.T) {
+	t.Parallel()
+
+	obj := fp.Must(tmdb.New(fixture(t).Config()))
+
+	profiles, err := obj.GetPersonImages(t.Context(), 287)
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.Image{poster("/profile.jpg", "", 5.4, 8, 500)}, profiles)
+
+	_, err = obj.GetPersonImages(t.Context(), 404)
+	assertPublic(t, err, "The resource you requested could not be found.")
+}
+
+func TestImagesBestPoster(t *testing.T) {
+	t.Parallel()
+
+	images := tmdb.Images{Backdrops: nil, Logos: nil, Posters: []tmdb.Image{
+		poster("/de-small.jpg", "de", 5.5, 10, 500),
+		poster("/de-large.jpg", "de", 5.5, 10, 2000),
+		poster("/de-popular.jpg", "de", 5.5, 30, 1000),
+		poster("/de-low.jpg", "de", 5.1, 90, 3000),
+		poster("/textless.jpg", "", 5.8, 40, 2000),
+		poster("/textless-low.jpg", "", 5.2, 40, 2000),
+	}}
+
+	tests := []struct {
+		name     string
+		language string
+		want     string
+	}{
+		{name: "votes", language: "de", want: "/de-popular.jpg"},
+		{name: "region", language: "de-CH", want: "/de-popular.jpg"},
+		{name: "fallback", language: "ja", want: "/textless.jpg"},
+		{name: "no language", language: "", want: "/textless.jpg"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, found := images.BestPoster(test.language)
+
+			require.True(t, found)
+			assert.Equal(t, test.want, got.FilePath)
+		})
+	}
+
+	german := tmdb.Images{Backdrops: nil, Logos: nil, Posters: images.Posters[:2]}
+
+	got, found := german.BestPoster("ja")
+
+	assert.False(t, found)
+	assert.Empty(t, got.FilePath)
+
+	got, found = german.BestPoster("de")
+
+	require.True(t, found)
+	assert.Equal(t, "https://image.tmdb.org/t/p/w500/de-large.jpg", got.URL("w500"))
+}
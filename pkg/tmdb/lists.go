@@ -0,0 +1,223 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	pathList      = "/4/list"
+	pathListItems = "/items"
+	pathListClear = "/clear"
+
+	defaultListLanguage = "en"
+)
+
+type (
+	// ListOptions describe a v4 list. UpdateList sends all of them but Language, so zero values overwrite the list.
+	ListOptions struct {
+		Name        string
+		Description string
+		Language    string
+		Public      bool
+	}
+
+	// ListEntry points at a list item. Comment is set by AddListItems and UpdateListItems, RemoveListItems ignores it.
+	ListEntry struct {
+		MediaType MediaType `json:"media_type"`
+		Comment   string    `json:"comment,omitempty"`
+		MediaID   int       `json:"media_id"`
+	}
+
+	listResponse struct {
+		ID int `json:"id"`
+	}
+
+	itemsResponse struct {
+		Results []struct {
+			MediaType MediaType `json:"media_type"`
+			MediaID   int       `json:"media_id"`
+			Success   bool      `json:"success"`
+		} `json:"results"`
+	}
+)
+
+// Comment returns what the list owner wrote about the item, if anything.
+func (l List) Comment(item ListItem) string {
+	return l.Comments[itemKey(item.MediaType, item.ID)]
+}
+
+// CreateList creates a list owned by the user of Config.AccessToken and returns its id.
+func (c *TMDB) CreateList(ctx context.Context, opts ListOptions) (int, error) {
+	var data listResponse
+
+	if opts.Name == "" {
+		return 0, c.invalidArgument("name", "List name is required.")
+	}
+
+	if opts.Language == "" {
+		opts.Language = defaultListLanguage
+	}
+
+	body := map[string]any{
+		"name":        opts.Name,
+		"description": opts.Description,
+		"iso_639_1":   opts.Language,
+		"public":      opts.Public,
+	}
+
+	return data.ID, c.write(ctx, http.MethodPost, "CreateList", pathList, body, &data)
+}
+
+func (c *TMDB) UpdateList(ctx context.Context, listID int, opts ListOptions) error {
+	var data errorResponse
+
+	if opts.Name == "" {
+		return c.invalidArgument("name", "List name is required.")
+	}
+
+	body := map[string]any{"name": opts.Name, "description": opts.Description, "public": opts.Public}
+
+	return c.write(ctx, http.MethodPut, "UpdateList", listPath(listID), body, &data)
+}
+
+// GetList returns a page of the list items. Private lists are only visible with the access token of their owner.
+func (c *TMDB) GetList(ctx context.Context, listID int, page int) (List, error) {
+	var data List
+
+	params := map[string]string{"page": strconv.Itoa(page)}
+
+	return data, c.v4(ctx, http.MethodGet, "GetList", listPath(listID), params, nil, &data)
+}
+
+func (c *TMDB) AddListItems(ctx context.Context, listID int, items []ListEntry) error {
+	return c.items(ctx, http.MethodPost, "AddListItems", listID, items)
+}
+
+// UpdateListItems replaces the comments of items already in the list.
+func (c *TMDB) UpdateListItems(ctx context.Context, listID int, items []ListEntry) error {
+	return c.items(ctx, http.MethodPut, "UpdateListItems", listID, items)
+}
+
+func (c *TMDB) RemoveListItems(ctx context.Context, listID int, items []ListEntry) error {
+	return c.items(ctx, http.MethodDelete, "RemoveListItems", listID, items)
+}
+
+// ClearList removes every item but keeps the list itself.
+func (c *TMDB) ClearList(ctx context.Context, listID int) error {
+	var data errorResponse
+
+	if err := c.authorised(); err != nil {
+		return err
+	}
+
+	// TMDB clears lists on GET, the call is safe to repeat all the same
+	params := make(map[string]string)
+
+	return c.v4(ctx, http.MethodGet, "ClearList", listPath(listID)+pathListClear, params, nil, &data)
+}
+
+func (c *TMDB) DeleteList(ctx context.Context, listID int) error {
+	var data errorResponse
+
+	return c.write(ctx, http.MethodDelete, "DeleteList", listPath(listID), nil, &data)
+}
+
+func (c *TMDB) items(ctx context.Context, method, operation string, listID int, items []ListEntry) error {
+	var data itemsResponse
+
+	if len(items) == 0 {
+		return c.invalidArgument("items", "At least one list item is required.")
+	}
+
+	for _, item := range items {
+		if err := c.media(item.MediaType); err != nil {
+			return err
+		}
+	}
+
+	body := map[string]any{"items": items}
+
+	err := c.write(ctx, method, operation, listPath(listID)+pathListItems, body, &data)
+	if err != nil {
+		return err
+	}
+
+	failed := make([]string, 0)
+
+	for _, result := range data.Results {
+		if !result.Success {
+			failed = append(failed, itemKey(result.MediaType, result.MediaID))
+		}
+	}
+
+	if len(failed) > 0 {
+		return c.oops.Code(errResponse).
+			With("items", failed).
+			Public("List items not changed: " + strings.Join(failed, ", ") + ".").
+			New("list items failed")
+	}
+
+	return nil
+}
+
+// write makes a v4 call that changes a list. It needs the user access token.
+func (c *TMDB) write(ctx context.Context, method, operation, path string, body, result any) error {
+	if err := c.authorised(); err != nil {
+		return err
+	}
+
+	return c.v4(ctx, method, operation, path, make(map[string]string), body, result)
+}
+
+// v4 makes a call authorised by the user access token when there is one, and by Token otherwise. TMDB v4 does not
+// take an API key, so in AuthAPIKey mode the call needs the user access token and fails before it is sent without one.
+func (c *TMDB) v4(
+	ctx context.Context, method, operation, path string, params map[string]string, body, result any,
+) error {
+	header := make(http.Header)
+
+	switch {
+	case c.config.AccessToken != "":
+		header.Set(headerAuthorization, bearerPrefix+c.config.AccessToken)
+	case c.config.Auth == AuthAPIKey:
+		return c.oops.Code(errInvalidArgument).
+			Public("Access token required: TMDB v4 does not take an API key, log in with a v4 user access token.").
+			New("api key on v4")
+	}
+
+	return c.doer.Do(ctx, &Call{
+		Result:    result,
+		Body:      body,
+		Query:     params,
+		Header:    header,
+		Operation: operation,
+		Method:    method,
+		Path:      path,
+		Status:    0,
+		Code:      0,
+		Attempt:   0,
+		Latency:   0,
+		Shared:    false,
+	})
+}
+
+func (c *TMDB) authorised() error {
+	if c.config.AccessToken == "" {
+		return c.oops.Code(errInvalidArgument).
+			Public("Access token required: Log in with a v4 user access token to change lists.").
+			New("no access token")
+	}
+
+	return nil
+}
+
+func listPath(listID int) string {
+	return pathList + "/" + strconv.Itoa(listID)
+}
+
+func itemKey(media MediaType, mediaID int) string {
+	return string(media) + ":" + strconv.Itoa(mediaID)
+}
@@ -0,0 +1,183 @@
+package tmdb_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
+)
+
+func TestTMDBAccessTokenFlow(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	request, err := obj.CreateAccessRequest(t.Context())
+
+	require.NoError(t, err)
+	assert.True(t, request.Success)
+	assert.Equal(t, "https://www.themoviedb.org/auth/access?request_token="+request.RequestToken,
+		request.ApprovalURL())
+
+	_, err = obj.CreateAccessToken(t.Context(), request.RequestToken)
+
+	assertPublic(t, err, "Session denied.")
+
+	server.Approve(request.RequestToken)
+
+	access, err := obj.CreateAccessToken(t.Context(), request.RequestToken)
+
+	require.NoError(t, err)
+	assert.Equal(t, tmdbtest.AccessAccountID, access.AccountID)
+	assert.True(t, server.Access(access.AccessToken))
+
+	require.NoError(t, obj.DeleteAccessToken(t.Context(), access.AccessToken))
+	assert.False(t, server.Access(access.AccessToken))
+
+	err = obj.DeleteAccessToken(t.Context(), access.AccessToken)
+
+	assertPublic(t, err, "Invalid id: The pre-requisite id is invalid or not found.")
+
+	_, err = obj.CreateAccessToken(t.Context(), "")
+	assertPublic(t, err, "Request token is required.")
+
+	err = obj.DeleteAccessToken(t.Context(), "")
+	assertPublic(t, err, "Access token is required.")
+}
+
+func TestTMDBLists(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(withAccess(t, server)))
+
+	listID, err := obj.CreateList(t.Context(), tmdb.ListOptions{
+		Name:        "Weekend",
+		Description: "To watch",
+		Language:    "",
+		Public:      false,
+	})
+
+	require.NoError(t, err)
+
+	err = obj.AddListItems(t.Context(), listID, []tmdb.ListEntry{
+		{MediaType: tmdb.MediaMovie, Comment: "again", MediaID: 550},
+		{MediaType: tmdb.MediaTV, Comment: "", MediaID: 1396},
+	})
+
+	require.NoError(t, err)
+
+	err = obj.AddListItems(t.Context(), listID, []tmdb.ListEntry{
+		{MediaType: tmdb.MediaMovie, Comment: "", MediaID: 550},
+		{MediaType: tmdb.MediaMovie, Comment: "", MediaID: 13},
+		{MediaType: tmdb.MediaMovie, Comment: "", MediaID: 404},
+	})
+
+	assertPublic(t, err, "List items not changed: movie:550, movie:404.")
+
+	list, err := obj.GetList(t.Context(), listID, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Weekend", list.Name)
+	assert.Equal(t, "en", list.Language)
+	assert.Equal(t, 3, list.TotalResults)
+	require.Len(t, list.Results, 3)
+	assert.Equal(t, "Fight Club", list.Results[0].Title)
+	assert.Equal(t, "again", list.Comment(list.Results[0]))
+	assert.Equal(t, "Breaking Bad", list.Results[1].Name)
+	assert.Empty(t, list.Comment(list.Results[1]))
+
+	update := []tmdb.ListEntry{{MediaType: tmdb.MediaTV, Comment: "season 5", MediaID: 1396}}
+
+	require.NoError(t, obj.UpdateListItems(t.Context(), listID, update))
+	require.NoError(t, obj.RemoveListItems(t.Context(), listID, []tmdb.ListEntry{
+		{MediaType: tmdb.MediaMovie, Comment: "", MediaID: 550},
+	}))
+	assert.Equal(t, []tmdb.ListEntry{
+		{MediaType: tmdb.MediaTV, Comment: "season 5", MediaID: 1396},
+		{MediaType: tmdb.MediaMovie, Comment: "", MediaID: 13},
+	}, server.ListItems(listID))
+
+	opts := tmdb.ListOptions{Name: "Someday", Description: "", Language: "", Public: true}
+
+	require.NoError(t, obj.UpdateList(t.Context(), listID, opts))
+	require.NoError(t, obj.ClearList(t.Context(), listID))
+
+	list, err = fp.Must(tmdb.New(server.Config())).GetList(t.Context(), listID, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Someday", list.Name)
+	assert.True(t, list.Public)
+	assert.Empty(t, list.Results)
+
+	require.NoError(t, obj.DeleteList(t.Context(), listID))
+	assert.Nil(t, server.ListItems(listID))
+
+	_, err = obj.GetList(t.Context(), listID, 1)
+
+	assertPublic(t, err, "The resource you requested could not be found.")
+}
+
+func TestTMDBListsWithAPIKey(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	config := withAccess(t, server)
+	config.Auth = tmdb.AuthAPIKey
+
+	obj := fp.Must(tmdb.New(config))
+
+	opts := tmdb.ListOptions{Name: "Keyed", Description: "", Language: "", Public: false}
+
+	listID, err := obj.CreateList(t.Context(), opts)
+
+	require.NoError(t, err)
+	assert.NotNil(t, server.ListItems(listID))
+
+	config.AccessToken = ""
+	keyed := fp.Must(tmdb.New(config))
+
+	_, err = keyed.GetList(t.Context(), listID, 1)
+
+	assertPublic(t, err, "Access token required: TMDB v4 does not take an API key, log in with a v4 user access token.")
+	assert.Zero(t, server.Hits("/4/list/"+strconv.Itoa(listID)))
+}
+
+func TestTMDBListsFailure(t *testing.T) {
+	t.Parallel()
+
+	const noAccess = "Access token required: Log in with a v4 user access token to change lists."
+
+	server := fixture(t)
+	anonymous := fp.Must(tmdb.New(server.Config()))
+	items := []tmdb.ListEntry{{MediaType: tmdb.MediaMovie, Comment: "", MediaID: 550}}
+	opts := tmdb.ListOptions{Name: "Weekend", Description: "", Language: "", Public: false}
+
+	_, err := anonymous.CreateList(t.Context(), opts)
+	assertPublic(t, err, noAccess)
+	assertPublic(t, anonymous.UpdateList(t.Context(), 1, opts), noAccess)
+	assertPublic(t, anonymous.AddListItems(t.Context(), 1, items), noAccess)
+	assertPublic(t, anonymous.ClearList(t.Context(), 1), noAccess)
+	assertPublic(t, anonymous.DeleteList(t.Context(), 1), noAccess)
+	assert.Zero(t, server.Hits("/4/list"))
+
+	obj := fp.Must(tmdb.New(withAccess(t, server)))
+	listID := fp.Must(obj.CreateList(t.Context(), opts))
+
+	_, err = anonymous.GetList(t.Context(), listID, 1)
+	assertPublic(t, err, "Authentication failed: You do not have permissions to access the service.")
+
+	_, err = obj.CreateList(t.Context(), tmdb.ListOptions{Name: "", Description: "", Language: "", Public: false})
+	assertPublic(t, err, "List name is required.")
+	assertPublic(t, obj.AddListItems(t.Context(), listID, nil), "At least one list item is required.")
+	assertPublic(t, obj.RemoveListItems(t.Context(), listID, []tmdb.ListEntry{
+		{MediaType: tmdb.MediaType("person"), Comment: "", MediaID: 287},
+	}), "Unknown media type: Allowed [movie,tv].")
+	assertPublic(t, obj.UpdateListItems(t.Context(), listID, items), "List items not changed: movie:550.")
+}
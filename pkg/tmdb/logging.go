@@ -0,0 +1,58 @@
+package tmdb
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+
+	"github.com/samber/oops"
+)
+
+const logMessage = "tmdb request"
+
+func logging(logger *slog.Logger) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, call *Call) error {
+			err := next.Do(ctx, call)
+
+			attrs := []slog.Attr{
+				slog.String("operation", call.Operation),
+				slog.String("method", call.Method),
+				slog.String("path", call.Path),
+				slog.Any("query", redactQuery(call.Query)),
+				slog.Int("status", call.Status),
+				slog.Duration("duration", call.Latency),
+				slog.Int("attempt", call.Attempt),
+				slog.Bool("shared", call.Shared),
+			}
+
+			level := slog.LevelInfo
+
+			if err != nil {
+				level = slog.LevelError
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+
+			// the code tells a call the breaker short-circuited from one that failed at TMDB
+			if orr, ok := oops.AsOops(err); ok {
+				attrs = append(attrs, slog.Any("code", orr.Code()))
+			}
+
+			logger.LogAttrs(ctx, level, logMessage, attrs...)
+
+			return err
+		})
+	}
+}
+
+func redactQuery(query map[string]string) map[string]string {
+	clean := maps.Clone(query)
+
+	for param := range clean {
+		if secret(param) {
+			clean[param] = redacted
+		}
+	}
+
+	return clean
+}
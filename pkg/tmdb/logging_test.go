@@ -0,0 +1,174 @@
+package tmdb_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
+)
+
+const apiKey = "0123456789abcdef0123456789abcdef"
+
+func loggedClient(output *bytes.Buffer, token string) *tmdb.TMDB {
+	opts := &slog.HandlerOptions{AddSource: false, Level: slog.LevelDebug, ReplaceAttr: nil}
+
+	return fp.Must(tmdb.New(tmdb.Config{
+		Logger:         slog.New(slog.NewJSONHandler(output, opts)),
+		Tracing:        nil,
+		Metrics:        nil,
+		Breaker:        nil,
+		Timeout:        time.Minute,
+		Host:           "https://tmdb.host",
+		Token:          token,
+		Auth:           "",
+		SessionID:      "",
+		GuestSessionID: "",
+		AccessToken:    "",
+		Language:       "",
+		Region:         "",
+	}))
+}
+
+func record(t *testing.T, output *bytes.Buffer) map[string]any {
+	t.Helper()
+
+	var got map[string]any
+
+	require.NoError(t, json.Unmarshal(output.Bytes(), &got))
+
+	delete(got, "time")
+	delete(got, "duration")
+
+	return got
+}
+
+func TestTMDBLoggingSuccess(t *testing.T) {
+	t.Parallel()
+
+	output := new(bytes.Buffer)
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.Anything).Return(successResponse(t), nil)
+
+	obj := loggedClient(output, "secret").SetTransport(trans)
+	_, err := obj.GetPopularMovies(t.Context(), 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"level":     "INFO",
+		"msg":       "tmdb request",
+		"operation": "GetPopularMovies",
+		"method":    http.MethodGet,
+		"path":      "/3/movie/popular",
+		"query":     map[string]any{"page": "2"},
+		"status":    float64(http.StatusOK),
+		"attempt":   float64(1),
+		"shared":    false,
+	}, record(t, output))
+	assert.NotContains(t, output.String(), "secret")
+}
+
+func TestTMDBLoggingFailureRedacted(t *testing.T) {
+	t.Parallel()
+
+	output := new(bytes.Buffer)
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.Anything).Return(nil, errFail)
+
+	obj := loggedClient(output, apiKey).SetTransport(trans)
+	_, err := obj.GetPopularMovies(t.Context(), 1)
+
+	require.Error(t, err)
+
+	got := record(t, output)
+
+	assert.Equal(t, "ERROR", got["level"])
+	assert.Equal(t, `Get "https://tmdb.host/3/movie/popular?api_key=REDACTED&language=en&page=1": fail`, got["error"])
+	assert.Equal(t, "responseError", got["code"])
+	assert.NotContains(t, output.String(), apiKey)
+}
+
+func TestTMDBLoggingQueryRedacted(t *testing.T) {
+	t.Parallel()
+
+	output := new(bytes.Buffer)
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.Anything).Return(successResponse(t), nil)
+
+	obj := loggedClient(output, "secret").SetTransport(trans).Use(func(next tmdb.Doer) tmdb.Doer {
+		return tmdb.DoerFunc(func(ctx context.Context, call *tmdb.Call) error {
+			call.Query["api_key"] = apiKey
+
+			return next.Do(ctx, call)
+		})
+	})
+	_, err := obj.GetPopularMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"api_key": "REDACTED", "page": "1"}, record(t, output)["query"])
+	assert.NotContains(t, output.String(), apiKey)
+}
+
+func TestTMDBLoggingSharedAndCircuit(t *testing.T) {
+	t.Parallel()
+
+	output := new(bytes.Buffer)
+	server := fixture(t).Script(tmdbtest.Fault{Path: "/3/movie/popular", Status: 0, Delay: flightDelay, Times: 1})
+
+	config := server.Config()
+	config.Logger = slog.New(slog.NewJSONHandler(output, nil))
+	config.Breaker = tmdb.NewBreaker(tmdb.BreakerConfig{
+		FailureRatio: 0.25,
+		MinRequests:  1,
+		Window:       time.Minute,
+		Cooldown:     time.Minute,
+	})
+	obj := fp.Must(tmdb.New(config))
+
+	var group sync.WaitGroup
+
+	for range 2 {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			_, _ = obj.GetPopularMovies(t.Context(), 1)
+		}()
+	}
+
+	group.Wait()
+
+	server.Script(tmdbtest.Fault{Path: "", Status: http.StatusServiceUnavailable, Delay: 0, Times: 1})
+
+	_, _ = obj.GetTopRatedMovies(t.Context(), 1)
+	_, _ = obj.GetTopRatedMovies(t.Context(), 2)
+
+	var records []map[string]any
+
+	for line := range bytes.Lines(output.Bytes()) {
+		var got map[string]any
+
+		require.NoError(t, json.Unmarshal(line, &got))
+
+		records = append(records, got)
+	}
+
+	require.Len(t, records, 4)
+	assert.ElementsMatch(t, []any{false, true}, []any{records[0]["shared"], records[1]["shared"]})
+	assert.Equal(t, "responseError", records[2]["code"])
+	assert.Equal(t, "circuitOpen", records[3]["code"])
+	assert.Equal(t, false, records[3]["shared"])
+}
@@ -0,0 +1,211 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	ClassError = "error"
+
+	statusClassSize       = 100
+	prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+type (
+	// Metrics receives one Sample per logical call once it completes.
+	Metrics interface {
+		Observe(ctx context.Context, sample Sample)
+	}
+
+	// Sample is a finished call. Class is the status class ("2xx", "4xx", "5xx"...) or ClassError when no response
+	// was received. Retries counts attempts beyond the first one.
+	Sample struct {
+		Operation string
+		Class     string
+		Latency   time.Duration
+		Retries   int
+	}
+
+	// PrometheusMetrics aggregates samples in memory and renders them in the Prometheus text exposition format.
+	PrometheusMetrics struct {
+		breaker  *Breaker
+		requests map[requestKey]int
+		latency  map[string]*histogram
+		retries  map[string]int
+		buckets  []float64
+		mutex    sync.Mutex
+	}
+
+	requestKey struct {
+		operation string
+		class     string
+	}
+
+	histogram struct {
+		counts []int
+		sum    float64
+		count  int
+	}
+)
+
+func metrics(sink Metrics) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, call *Call) error {
+			err := next.Do(ctx, call)
+
+			sink.Observe(ctx, Sample{
+				Operation: call.Operation,
+				Class:     statusClass(call.Status),
+				Latency:   call.Latency,
+				Retries:   max(call.Attempt-1, 0),
+			})
+
+			return err
+		})
+	}
+}
+
+func statusClass(status int) string {
+	if status == 0 {
+		return ClassError
+	}
+
+	return strconv.Itoa(status/statusClassSize) + "xx"
+}
+
+func NewPrometheusMetrics() *PrometheusMetrics {
+	return &PrometheusMetrics{
+		breaker:  nil,
+		requests: make(map[requestKey]int),
+		latency:  make(map[string]*histogram),
+		retries:  make(map[string]int),
+		buckets:  []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		mutex:    sync.Mutex{},
+	}
+}
+
+// WatchBreaker adds the state of breaker to the output as the tmdb_circuit_state gauge.
+func (m *PrometheusMetrics) WatchBreaker(breaker *Breaker) *PrometheusMetrics {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.breaker = breaker
+
+	return m
+}
+
+func (m *PrometheusMetrics) Observe(_ context.Context, sample Sample) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.requests[requestKey{operation: sample.Operation, class: sample.Class}]++
+	m.retries[sample.Operation] += sample.Retries
+
+	hist, ok := m.latency[sample.Operation]
+	if !ok {
+		hist = &histogram{counts: make([]int, len(m.buckets)), sum: 0, count: 0}
+		m.latency[sample.Operation] = hist
+	}
+
+	seconds := sample.Latency.Seconds()
+
+	for idx, bound := range m.buckets {
+		if seconds <= bound {
+			hist.counts[idx]++
+		}
+	}
+
+	hist.sum += seconds
+	hist.count++
+}
+
+func (m *PrometheusMetrics) WriteTo(output io.Writer) (int64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	text := new(strings.Builder)
+
+	m.writeRequests(text)
+	m.writeLatency(text)
+	m.writeRetries(text)
+	m.writeBreaker(text)
+
+	written, err := io.WriteString(output, text.String())
+
+	return int64(written), err
+}
+
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", prometheusContentType)
+
+	_, _ = m.WriteTo(w)
+}
+
+func (m *PrometheusMetrics) writeRequests(text *strings.Builder) {
+	keys := slices.SortedFunc(maps.Keys(m.requests), func(a, b requestKey) int {
+		return strings.Compare(a.operation+" "+a.class, b.operation+" "+b.class)
+	})
+
+	text.WriteString("# HELP tmdb_requests_total TMDB calls by operation and status class.\n")
+	text.WriteString("# TYPE tmdb_requests_total counter\n")
+
+	for _, key := range keys {
+		fmt.Fprintf(text, "tmdb_requests_total{operation=%q,class=%q} %d\n", key.operation, key.class, m.requests[key])
+	}
+}
+
+func (m *PrometheusMetrics) writeLatency(text *strings.Builder) {
+	text.WriteString("# HELP tmdb_request_duration_seconds TMDB call latency by operation.\n")
+	text.WriteString("# TYPE tmdb_request_duration_seconds histogram\n")
+
+	for _, operation := range slices.Sorted(maps.Keys(m.latency)) {
+		hist := m.latency[operation]
+
+		for idx, bound := range m.buckets {
+			fmt.Fprintf(text, "tmdb_request_duration_seconds_bucket{operation=%q,le=%q} %d\n",
+				operation, strconv.FormatFloat(bound, 'g', -1, 64), hist.counts[idx])
+		}
+
+		fmt.Fprintf(text, "tmdb_request_duration_seconds_bucket{operation=%q,le=\"+Inf\"} %d\n", operation, hist.count)
+		fmt.Fprintf(text, "tmdb_request_duration_seconds_sum{operation=%q} %g\n", operation, hist.sum)
+		fmt.Fprintf(text, "tmdb_request_duration_seconds_count{operation=%q} %d\n", operation, hist.count)
+	}
+}
+
+func (m *PrometheusMetrics) writeRetries(text *strings.Builder) {
+	text.WriteString("# HELP tmdb_retries_total TMDB attempts beyond the first one by operation.\n")
+	text.WriteString("# TYPE tmdb_retries_total counter\n")
+
+	for _, operation := range slices.Sorted(maps.Keys(m.retries)) {
+		fmt.Fprintf(text, "tmdb_retries_total{operation=%q} %d\n", operation, m.retries[operation])
+	}
+}
+
+func (m *PrometheusMetrics) writeBreaker(text *strings.Builder) {
+	if m.breaker == nil {
+		return
+	}
+
+	current := m.breaker.State()
+
+	text.WriteString("# HELP tmdb_circuit_state TMDB circuit breaker state, 1 for the current one.\n")
+	text.WriteString("# TYPE tmdb_circuit_state gauge\n")
+
+	for _, state := range []BreakerState{BreakerClosed, BreakerHalfOpen, BreakerOpen} {
+		value := 0
+		if state == current {
+			value = 1
+		}
+
+		fmt.Fprintf(text, "tmdb_circuit_state{state=%q} %d\n", state, value)
+	}
+}
@@ -0,0 +1,133 @@
+package tmdb_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/mocks"
+)
+
+func TestPrometheusMetricsWriteTo(t *testing.T) {
+	t.Parallel()
+
+	want := `# HELP tmdb_requests_total TMDB calls by operation and status class.
+# TYPE tmdb_requests_total counter
+tmdb_requests_total{operation="GetMovieDetails",class="error"} 1
+tmdb_requests_total{operation="GetPopularMovies",class="2xx"} 2
+tmdb_requests_total{operation="GetPopularMovies",class="4xx"} 1
+# HELP tmdb_request_duration_seconds TMDB call latency by operation.
+# TYPE tmdb_request_duration_seconds histogram
+tmdb_request_duration_seconds_bucket{operation="GetMovieDetails",le="0.05"} 0
+tmdb_request_duration_seconds_bucket{operation="GetMovieDetails",le="0.1"} 0
+tmdb_request_duration_seconds_bucket{operation="GetMovieDetails",le="0.25"} 0
+tmdb_request_duration_seconds_bucket{operation="GetMovieDetails",le="0.5"} 0
+tmdb_request_duration_seconds_bucket{operation="GetMovieDetails",le="1"} 0
+tmdb_request_duration_seconds_bucket{operation="GetMovieDetails",le="2.5"} 0
+tmdb_request_duration_seconds_bucket{operation="GetMovieDetails",le="5"} 0
+tmdb_request_duration_seconds_bucket{operation="GetMovieDetails",le="10"} 1
+tmdb_request_duration_seconds_bucket{operation="GetMovieDetails",le="30"} 1
+tmdb_request_duration_seconds_bucket{operation="GetMovieDetails",le="+Inf"} 1
+tmdb_request_duration_seconds_sum{operation="GetMovieDetails"} 10
+tmdb_request_duration_seconds_count{operation="GetMovieDetails"} 1
+tmdb_request_duration_seconds_bucket{operation="GetPopularMovies",le="0.05"} 1
+tmdb_request_duration_seconds_bucket{operation="GetPopularMovies",le="0.1"} 1
+tmdb_request_duration_seconds_bucket{operation="GetPopularMovies",le="0.25"} 2
+tmdb_request_duration_seconds_bucket{operation="GetPopularMovies",le="0.5"} 2
+tmdb_request_duration_seconds_bucket{operation="GetPopularMovies",le="1"} 3
+tmdb_request_duration_seconds_bucket{operation="GetPopularMovies",le="2.5"} 3
+tmdb_request_duration_seconds_bucket{operation="GetPopularMovies",le="5"} 3
+tmdb_request_duration_seconds_bucket{operation="GetPopularMovies",le="10"} 3
+tmdb_request_duration_seconds_bucket{operation="GetPopularMovies",le="30"} 3
+tmdb_request_duration_seconds_bucket{operation="GetPopularMovies",le="+Inf"} 3
+tmdb_request_duration_seconds_sum{operation="GetPopularMovies"} 1.25
+tmdb_request_duration_seconds_count{operation="GetPopularMovies"} 3
+# HELP tmdb_retries_total TMDB attempts beyond the first one by operation.
+# TYPE tmdb_retries_total counter
+tmdb_retries_total{operation="GetMovieDetails"} 2
+tmdb_retries_total{operation="GetPopularMovies"} 0
+`
+
+	obj := tmdb.NewPrometheusMetrics()
+	obj.Observe(t.Context(), sample("GetPopularMovies", "2xx", 50*time.Millisecond, 0))
+	obj.Observe(t.Context(), sample("GetPopularMovies", "2xx", 200*time.Millisecond, 0))
+	obj.Observe(t.Context(), sample("GetPopularMovies", "4xx", time.Second, 0))
+	obj.Observe(t.Context(), sample("GetMovieDetails", tmdb.ClassError, 10*time.Second, 2))
+
+	output := new(strings.Builder)
+	written, err := obj.WriteTo(output)
+
+	require.NoError(t, err)
+	assert.Equal(t, want, output.String())
+	assert.Equal(t, int64(len(want)), written)
+}
+
+func sample(operation, class string, latency time.Duration, retries int) tmdb.Sample {
+	return tmdb.Sample{Operation: operation, Class: class, Latency: latency, Retries: retries}
+}
+
+func TestPrometheusMetricsServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	obj := tmdb.NewPrometheusMetrics()
+	obj.Observe(t.Context(), sample("GetPopularMovies", "2xx", time.Millisecond, 0))
+
+	recorder := httptest.NewRecorder()
+	obj.ServeHTTP(recorder, httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), `tmdb_requests_total{operation="GetPopularMovies",class="2xx"} 1`)
+}
+
+func TestTMDBMetrics(t *testing.T) {
+	t.Parallel()
+
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.Anything).Return(successResponse(t), nil).Once()
+	trans.On("RoundTrip", mock.Anything).Return(failureResponse(t), nil).Once()
+	trans.On("RoundTrip", mock.Anything).Return(nil, errFail).Once()
+
+	sink := tmdb.NewPrometheusMetrics()
+	obj := fp.Must(tmdb.New(tmdb.Config{
+		Logger:         nil,
+		Tracing:        nil,
+		Metrics:        sink,
+		Breaker:        nil,
+		Timeout:        time.Minute,
+		Host:           "https://tmdb.host",
+		Token:          "secret",
+		Auth:           "",
+		SessionID:      "",
+		GuestSessionID: "",
+		AccessToken:    "",
+		Language:       "",
+		Region:         "",
+	})).SetTransport(trans)
+
+	_, errSuccess := obj.GetPopularMovies(t.Context(), 1)
+	_, errFailure := obj.GetPopularMovies(t.Context(), 2)
+	_, errTransport := obj.GetTopRatedMovies(t.Context(), 1)
+
+	require.NoError(t, errSuccess)
+	require.Error(t, errFailure)
+	require.Error(t, errTransport)
+
+	output := new(strings.Builder)
+	_, err := sink.WriteTo(output)
+
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), `tmdb_requests_total{operation="GetPopularMovies",class="2xx"} 1`)
+	assert.Contains(t, output.String(), `tmdb_requests_total{operation="GetPopularMovies",class="4xx"} 1`)
+	assert.Contains(t, output.String(), `tmdb_requests_total{operation="GetTopRatedMovies",class="error"} 1`)
+	assert.Contains(t, output.String(), `tmdb_request_duration_seconds_count{operation="GetPopularMovies"} 2`)
+	assert.Contains(t, output.String(), `tmdb_retries_total{operation="GetPopularMovies"} 0`)
+}
@@ -0,0 +1,57 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type (
+	// Call describes one logical API operation. Middlewares may change the request fields before calling the next
+	// Doer and read Status, Code, Attempt, Latency and Shared once it returns. Body, when set, is sent as JSON. Code
+	// is the TMDB `status_code` of an error body. Shared is set when the response came from an identical call already
+	// in flight.
+	Call struct {
+		Result    any
+		Body      any
+		Query     map[string]string
+		Header    http.Header
+		Operation string
+		Method    string
+		Path      string
+		Status    int
+		Code      int
+		Attempt   int
+		Latency   time.Duration
+		Shared    bool
+	}
+
+	Doer interface {
+		Do(ctx context.Context, call *Call) error
+	}
+
+	DoerFunc func(ctx context.Context, call *Call) error
+
+	Middleware func(next Doer) Doer
+)
+
+func (f DoerFunc) Do(ctx context.Context, call *Call) error {
+	return f(ctx, call)
+}
+
+// Use appends middlewares to the chain every endpoint goes through. The first registered middleware is the
+// outermost one. Like SetTransport, it is meant to be called before the client is shared.
+func (c *TMDB) Use(middlewares ...Middleware) *TMDB {
+	c.middlewares = append(c.middlewares, middlewares...)
+	c.chain()
+
+	return c
+}
+
+func (c *TMDB) chain() {
+	c.doer = DoerFunc(c.do)
+
+	for idx := len(c.middlewares) - 1; idx >= 0; idx-- {
+		c.doer = c.middlewares[idx](c.doer)
+	}
+}
@@ -0,0 +1,109 @@
+package tmdb_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/mocks"
+)
+
+func TestTMDBUse(t *testing.T) {
+	t.Parallel()
+
+	obj1 := New()
+	obj2 := obj1.Use(func(next tmdb.Doer) tmdb.Doer { return next })
+
+	assert.Same(t, obj1, obj2)
+}
+
+func TestTMDBUseOrderAndCall(t *testing.T) {
+	t.Parallel()
+
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Header.Get("X-Audit") == "outer, inner" && req.URL.Query().Get("page") == "2"
+	})).Return(successResponse(t), nil)
+
+	order := make([]string, 0)
+	calls := make([]tmdb.Call, 0)
+
+	trace := func(name string) tmdb.Middleware {
+		return func(next tmdb.Doer) tmdb.Doer {
+			return tmdb.DoerFunc(func(ctx context.Context, call *tmdb.Call) error {
+				order = append(order, name+":before")
+				call.Header.Add("X-Audit", name)
+
+				err := next.Do(ctx, call)
+
+				order = append(order, name+":after")
+				calls = append(calls, *call)
+
+				return oops.Wrap(err)
+			})
+		}
+	}
+
+	obj := New().SetTransport(trans).Use(trace("outer")).Use(trace("inner"))
+	got, err := obj.GetPopularMovies(t.Context(), 2)
+
+	require.NoError(t, err)
+	require.Len(t, calls, 2)
+
+	assert.Equal(t, want(), got)
+	assert.Equal(t, []string{"outer:before", "inner:before", "inner:after", "outer:after"}, order)
+	assert.Equal(t, "GetPopularMovies", calls[1].Operation)
+	assert.Equal(t, http.MethodGet, calls[1].Method)
+	assert.Equal(t, "/3/movie/popular", calls[1].Path)
+	assert.Equal(t, map[string]string{"page": "2"}, calls[1].Query)
+	assert.Equal(t, http.StatusOK, calls[1].Status)
+	assert.Positive(t, calls[1].Latency)
+}
+
+func TestTMDBUseStatusOnError(t *testing.T) {
+	t.Parallel()
+
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.Anything).Return(failureResponse(t), nil)
+
+	var (
+		status int
+		failed error
+	)
+
+	obj := New().SetTransport(trans).Use(func(next tmdb.Doer) tmdb.Doer {
+		return tmdb.DoerFunc(func(ctx context.Context, call *tmdb.Call) error {
+			failed = next.Do(ctx, call)
+			status = call.Status
+
+			return oops.Wrap(failed)
+		})
+	})
+
+	_, err := obj.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{Append: nil})
+
+	require.Error(t, err)
+	require.EqualError(t, err, failed.Error())
+	assert.Equal(t, http.StatusTeapot, status)
+}
+
+func TestTMDBUseFaultInjection(t *testing.T) {
+	t.Parallel()
+
+	obj := New().SetTransport(mocks.NewMockRoundTripper(t)).Use(func(tmdb.Doer) tmdb.Doer {
+		return tmdb.DoerFunc(func(context.Context, *tmdb.Call) error {
+			return errFail
+		})
+	})
+
+	got, err := obj.GetTopRatedMovies(t.Context(), 1)
+
+	require.ErrorIs(t, err, errFail)
+	assert.Empty(t, got)
+}
@@ -1,9 +1,414 @@
 package tmdb
 
-type Movie struct {
-	Title       string  `json:"title"`
-	Overview    string  `json:"overview"`
-	ReleaseDate string  `json:"release_date"`
-	Popularity  float64 `json:"popularity"`
-	VoteCount   int     `json:"vote_count"`
-}
+import "encoding/json"
+
+type (
+	Movie struct {
+		Title       string  `json:"title"`
+		Overview    string  `json:"overview"`
+		ReleaseDate string  `json:"release_date"`
+		Popularity  float64 `json:"popularity"`
+		ID          int     `json:"id"`
+		VoteCount   int     `json:"vote_count"`
+	}
+
+	// Page is one page of a paginated TMDB listing.
+	Page[T any] struct {
+		Results      []T `json:"results"`
+		Page         int `json:"page"`
+		TotalPages   int `json:"total_pages"`
+		TotalResults int `json:"total_results"`
+	}
+
+	MoviesPage = Page[Movie]
+
+	MovieDetails struct {
+		Credits         *Credits      `json:"credits,omitempty"`
+		Videos          *Videos       `json:"videos,omitempty"`
+		Images          *Images       `json:"images,omitempty"`
+		Keywords        *Keywords     `json:"keywords,omitempty"`
+		ReleaseDates    *ReleaseDates `json:"release_dates,omitempty"`
+		ExternalIDs     *ExternalIDs  `json:"external_ids,omitempty"`
+		Recommendations *MoviesPage   `json:"recommendations,omitempty"`
+		Similar         *MoviesPage   `json:"similar,omitempty"`
+		AltTitles       *AltTitles    `json:"alternative_titles,omitempty"`
+		Translations    *Translations `json:"translations,omitempty"`
+		Tagline         string        `json:"tagline"`
+		Status          string        `json:"status"`
+		IMDbID          string        `json:"imdb_id"`
+		Genres          []Genre       `json:"genres"`
+		Movie
+		VoteAverage float64 `json:"vote_average"`
+		Budget      int64   `json:"budget"`
+		Revenue     int64   `json:"revenue"`
+		Runtime     int     `json:"runtime"`
+	}
+
+	Genre struct {
+		Name string `json:"name"`
+		ID   int    `json:"id"`
+	}
+
+	Credits struct {
+		Cast []Cast `json:"cast"`
+		Crew []Crew `json:"crew"`
+	}
+
+	Cast struct {
+		Name      string `json:"name"`
+		Character string `json:"character"`
+		ID        int    `json:"id"`
+		Order     int    `json:"order"`
+	}
+
+	Crew struct {
+		Name       string `json:"name"`
+		Job        string `json:"job"`
+		Department string `json:"department"`
+		ID         int    `json:"id"`
+	}
+
+	Videos struct {
+		Results []Video `json:"results"`
+	}
+
+	Video struct {
+		Key      string `json:"key"`
+		Name     string `json:"name"`
+		Site     string `json:"site"`
+		Type     string `json:"type"`
+		Language string `json:"iso_639_1"`
+		Official bool   `json:"official"`
+	}
+
+	Images struct {
+		Backdrops []Image `json:"backdrops"`
+		Logos     []Image `json:"logos"`
+		Posters   []Image `json:"posters"`
+	}
+
+	// Image is a poster, backdrop, logo or profile. Language is empty for artwork without text, which TMDB calls
+	// the null language.
+	Image struct {
+		FilePath    string  `json:"file_path"`
+		Language    string  `json:"iso_639_1"`
+		AspectRatio float64 `json:"aspect_ratio"`
+		VoteAverage float64 `json:"vote_average"`
+		VoteCount   int     `json:"vote_count"`
+		Width       int     `json:"width"`
+		Height      int     `json:"height"`
+	}
+
+	Keywords struct {
+		Keywords []Keyword `json:"keywords"`
+	}
+
+	Keyword struct {
+		Name string `json:"name"`
+		ID   int    `json:"id"`
+	}
+
+	ReleaseDates struct {
+		Results []CountryReleaseDates `json:"results"`
+	}
+
+	CountryReleaseDates struct {
+		Country      string        `json:"iso_3166_1"`
+		ReleaseDates []ReleaseDate `json:"release_dates"`
+	}
+
+	ReleaseDate struct {
+		Certification string `json:"certification"`
+		ReleaseDate   string `json:"release_date"`
+		Note          string `json:"note"`
+		Type          int    `json:"type"`
+	}
+
+	AltTitles struct {
+		Titles []AltTitle `json:"titles"`
+	}
+
+	// AltTitle is a title the movie is known by in Country. Type tells unofficial ones apart, e.g. "working title".
+	AltTitle struct {
+		Country string `json:"iso_3166_1"`
+		Title   string `json:"title"`
+		Type    string `json:"type"`
+	}
+
+	Translations struct {
+		Translations []Translation `json:"translations"`
+	}
+
+	// Translation is the movie as translated for a language, in one of its regions. Empty data fields are not
+	// translated.
+	Translation struct {
+		Country     string          `json:"iso_3166_1"`
+		Language    string          `json:"iso_639_1"`
+		Name        string          `json:"name"`
+		EnglishName string          `json:"english_name"`
+		Data        TranslationData `json:"data"`
+	}
+
+	TranslationData struct {
+		Title    string `json:"title"`
+		Overview string `json:"overview"`
+		Tagline  string `json:"tagline"`
+		Homepage string `json:"homepage"`
+		Runtime  int    `json:"runtime"`
+	}
+
+	ExternalIDs struct {
+		IMDbID      string `json:"imdb_id"`
+		WikidataID  string `json:"wikidata_id"`
+		FacebookID  string `json:"facebook_id"`
+		InstagramID string `json:"instagram_id"`
+		TwitterID   string `json:"twitter_id"`
+	}
+
+	// RequestToken is the first step of the user authentication flow. The user approves it at ApprovalURL, then it
+	// is exchanged for a Session. ExpiresAt is in the TMDB format, e.g. "2016-08-26 17:04:39 UTC".
+	RequestToken struct {
+		RequestToken string `json:"request_token"`
+		ExpiresAt    string `json:"expires_at"`
+		Success      bool   `json:"success"`
+	}
+
+	Session struct {
+		SessionID string `json:"session_id"`
+		Success   bool   `json:"success"`
+	}
+
+	GuestSession struct {
+		GuestSessionID string `json:"guest_session_id"`
+		ExpiresAt      string `json:"expires_at"`
+		Success        bool   `json:"success"`
+	}
+
+	Account struct {
+		Username     string `json:"username"`
+		Name         string `json:"name"`
+		Language     string `json:"iso_639_1"`
+		Country      string `json:"iso_3166_1"`
+		ID           int    `json:"id"`
+		IncludeAdult bool   `json:"include_adult"`
+	}
+
+	TVShow struct {
+		Name         string  `json:"name"`
+		Overview     string  `json:"overview"`
+		FirstAirDate string  `json:"first_air_date"`
+		Popularity   float64 `json:"popularity"`
+		ID           int     `json:"id"`
+		VoteCount    int     `json:"vote_count"`
+	}
+
+	// TVShowDetails is a TV show with its seasons. Seasons only sum up each season, GetTVSeason has the episodes.
+	TVShowDetails struct {
+		Tagline        string          `json:"tagline"`
+		Status         string          `json:"status"`
+		LastAirDate    string          `json:"last_air_date"`
+		Genres         []Genre         `json:"genres"`
+		Networks       []Network       `json:"networks"`
+		Seasons        []SeasonSummary `json:"seasons"`
+		EpisodeRunTime []int           `json:"episode_run_time"`
+		TVShow
+		VoteAverage      float64 `json:"vote_average"`
+		NumberOfSeasons  int     `json:"number_of_seasons"`
+		NumberOfEpisodes int     `json:"number_of_episodes"`
+		InProduction     bool    `json:"in_production"`
+	}
+
+	Network struct {
+		Name    string `json:"name"`
+		Country string `json:"origin_country"`
+		ID      int    `json:"id"`
+	}
+
+	// SeasonSummary is a season as listed in the show details. Season 0 holds the specials.
+	SeasonSummary struct {
+		Name         string `json:"name"`
+		Overview     string `json:"overview"`
+		AirDate      string `json:"air_date"`
+		ID           int    `json:"id"`
+		SeasonNumber int    `json:"season_number"`
+		EpisodeCount int    `json:"episode_count"`
+	}
+
+	Season struct {
+		Name         string    `json:"name"`
+		Overview     string    `json:"overview"`
+		AirDate      string    `json:"air_date"`
+		Episodes     []Episode `json:"episodes"`
+		ID           int       `json:"id"`
+		SeasonNumber int       `json:"season_number"`
+	}
+
+	// Episode is an episode of a season. AirDate and Runtime are empty until TMDB knows them.
+	Episode struct {
+		Name          string  `json:"name"`
+		Overview      string  `json:"overview"`
+		AirDate       string  `json:"air_date"`
+		Crew          []Crew  `json:"crew"`
+		GuestStars    []Cast  `json:"guest_stars"`
+		VoteAverage   float64 `json:"vote_average"`
+		ID            int     `json:"id"`
+		ShowID        int     `json:"show_id"`
+		SeasonNumber  int     `json:"season_number"`
+		EpisodeNumber int     `json:"episode_number"`
+		Runtime       int     `json:"runtime"`
+		VoteCount     int     `json:"vote_count"`
+	}
+
+	// AggregateCredits are the credits of every season and episode of a show, a person once with all their roles.
+	AggregateCredits struct {
+		Cast []AggregateCast `json:"cast"`
+		Crew []AggregateCrew `json:"crew"`
+	}
+
+	AggregateCast struct {
+		Name              string `json:"name"`
+		Roles             []Role `json:"roles"`
+		ID                int    `json:"id"`
+		Order             int    `json:"order"`
+		TotalEpisodeCount int    `json:"total_episode_count"`
+	}
+
+	Role struct {
+		Character    string `json:"character"`
+		EpisodeCount int    `json:"episode_count"`
+	}
+
+	AggregateCrew struct {
+		Name              string `json:"name"`
+		Department        string `json:"department"`
+		Jobs              []Job  `json:"jobs"`
+		ID                int    `json:"id"`
+		TotalEpisodeCount int    `json:"total_episode_count"`
+	}
+
+	Job struct {
+		Job          string `json:"job"`
+		EpisodeCount int    `json:"episode_count"`
+	}
+
+	// Person is a cast or crew member. KnownFor holds a few of their movies and TV shows.
+	Person struct {
+		Name               string         `json:"name"`
+		KnownForDepartment string         `json:"known_for_department"`
+		KnownFor           []SearchResult `json:"known_for"`
+		Popularity         float64        `json:"popularity"`
+		ID                 int            `json:"id"`
+		Adult              bool           `json:"adult"`
+	}
+
+	// SearchResult is a multi-search result: MediaType tells which one of Movie, TVShow and Person is set. None is
+	// for a media type this client does not know yet.
+	SearchResult struct {
+		Movie     *Movie
+		TVShow    *TVShow
+		Person    *Person
+		MediaType MediaType
+	}
+
+	// RatedMovie is a movie from the account ratings, Rating is the value the user gave.
+	RatedMovie struct {
+		Movie
+		Rating float64 `json:"rating"`
+	}
+
+	RatedTVShow struct {
+		TVShow
+		Rating float64 `json:"rating"`
+	}
+
+	// AccountStates tells whether a movie is in the favorites and the watchlist of the account and how it was
+	// rated, zero meaning not rated.
+	AccountStates struct {
+		ID        int     `json:"id"`
+		Rated     float64 `json:"-"`
+		Favorite  bool    `json:"favorite"`
+		Watchlist bool    `json:"watchlist"`
+	}
+
+	// AccessRequest is the first step of the v4 user authentication flow. The user approves it at ApprovalURL, then
+	// it is exchanged for an AccessToken.
+	AccessRequest struct {
+		RequestToken string `json:"request_token"`
+		Success      bool   `json:"success"`
+	}
+
+	// AccessToken is a v4 user access token. AccountID is the v4 account object id, not the v3 numeric one.
+	AccessToken struct {
+		AccessToken string `json:"access_token"`
+		AccountID   string `json:"account_id"`
+		Success     bool   `json:"success"`
+	}
+
+	// List is a v4 user list with one page of its items. Comments are keyed by "media_type:id", see Comment.
+	List struct {
+		Comments     map[string]string `json:"comments"`
+		Name         string            `json:"name"`
+		Description  string            `json:"description"`
+		Language     string            `json:"iso_639_1"`
+		Results      []ListItem        `json:"results"`
+		ID           int               `json:"id"`
+		Page         int               `json:"page"`
+		TotalPages   int               `json:"total_pages"`
+		TotalResults int               `json:"total_results"`
+		Public       bool              `json:"public"`
+	}
+
+	// ListItem is a movie or a TV show in a list: movies fill Title and ReleaseDate, shows Name and FirstAirDate.
+	ListItem struct {
+		MediaType    MediaType `json:"media_type"`
+		Title        string    `json:"title"`
+		Name         string    `json:"name"`
+		Overview     string    `json:"overview"`
+		ReleaseDate  string    `json:"release_date"`
+		FirstAirDate string    `json:"first_air_date"`
+		Popularity   float64   `json:"popularity"`
+		ID           int       `json:"id"`
+		VoteCount    int       `json:"vote_count"`
+	}
+
+	// ChangedItem is an id of a movie, TV show or person changed in the requested date range.
+	ChangedItem struct {
+		ID    int  `json:"id"`
+		Adult bool `json:"adult"`
+	}
+
+	// Change groups the edits of one field, named by Key (e.g. "title", "images"), of a movie, TV show or person.
+	Change struct {
+		Key   string       `json:"key"`
+		Items []ChangeItem `json:"items"`
+	}
+
+	// ChangeItem is a single edit. Value and OriginalValue keep the raw JSON, their shape depends on the key.
+	ChangeItem struct {
+		ID            string          `json:"id"`
+		Action        string          `json:"action"`
+		Time          string          `json:"time"`
+		Language      string          `json:"iso_639_1"`
+		Country       string          `json:"iso_3166_1"`
+		Value         json.RawMessage `json:"value,omitempty"`
+		OriginalValue json.RawMessage `json:"original_value,omitempty"`
+	}
+
+	Country struct {
+		Code        string `json:"iso_3166_1"`
+		EnglishName string `json:"english_name"`
+		NativeName  string `json:"native_name"`
+	}
+
+	Language struct {
+		Code        string `json:"iso_639_1"`
+		EnglishName string `json:"english_name"`
+		Name        string `json:"name"`
+	}
+
+	// Timezones are the zones of a country.
+	Timezones struct {
+		Country string   `json:"iso_3166_1"`
+		Zones   []string `json:"zones"`
+	}
+)
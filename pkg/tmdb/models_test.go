@@ -14,6 +14,7 @@ func TestMovieStructure(t *testing.T) {
 		Overview:    "overview",
 		Popularity:  19.92,
 		ReleaseDate: "released",
+		ID:          42,
 		VoteCount:   666,
 	}
 }
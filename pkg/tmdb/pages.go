@@ -0,0 +1,153 @@
+package tmdb
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/samber/oops"
+)
+
+// MaxPages is the last page TMDB serves for any list endpoint.
+const MaxPages = 500
+
+type (
+	// PageOptions bounds the pages walked by the All* iterators. Zero values start at the first page and walk until
+	// `total_pages` or MaxPages, whichever comes first.
+	PageOptions struct {
+		Start int
+		Limit int
+	}
+
+	PageFunc func(ctx context.Context, page int) ([]Movie, error)
+
+	PageResult struct {
+		Err    error
+		Movies []Movie
+		Page   int
+	}
+)
+
+func (c *TMDB) AllNowPlayingMovies(ctx context.Context, opts PageOptions) iter.Seq2[Movie, error] {
+	return c.all(ctx, "GetNowPlayingMovies", pathNowPlaying, opts)
+}
+
+func (c *TMDB) AllPopularMovies(ctx context.Context, opts PageOptions) iter.Seq2[Movie, error] {
+	return c.all(ctx, "GetPopularMovies", pathPopular, opts)
+}
+
+func (c *TMDB) AllTopRatedMovies(ctx context.Context, opts PageOptions) iter.Seq2[Movie, error] {
+	return c.all(ctx, "GetTopRatedMovies", pathTopRated, opts)
+}
+
+func (c *TMDB) AllUpcomingMovies(ctx context.Context, opts PageOptions) iter.Seq2[Movie, error] {
+	return c.all(ctx, "GetUpcomingMovies", pathUpcoming, opts)
+}
+
+func (c *TMDB) all(ctx context.Context, operation, path string, opts PageOptions) iter.Seq2[Movie, error] {
+	return func(yield func(Movie, error) bool) {
+		page, last := opts.bounds()
+
+		for ; page <= last; page++ {
+			data, err := c.list(ctx, operation, path, page)
+			if err != nil {
+				var movie Movie
+
+				yield(movie, err)
+
+				return
+			}
+
+			for _, movie := range data.Results {
+				if !yield(movie, nil) {
+					return
+				}
+			}
+
+			last = min(last, data.TotalPages)
+		}
+	}
+}
+
+func (o PageOptions) bounds() (int, int) {
+	first := max(o.Start, 1)
+	last := MaxPages
+
+	if o.Limit > 0 {
+		last = min(first+o.Limit-1, MaxPages)
+	}
+
+	return first, last
+}
+
+// FetchPages fetches pages [first, last] with at most concurrency fetchers in flight and returns one result per page in
+// page order. Movies already seen on an earlier page are dropped, so titles shifting between pages appear once.
+func FetchPages(ctx context.Context, fetcher PageFunc, first, last, concurrency int) []PageResult {
+	if last < first {
+		return nil
+	}
+
+	results := make([]PageResult, last-first+1)
+	pages := make(chan int)
+
+	var group sync.WaitGroup
+
+	for range min(max(concurrency, 1), len(results)) {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			for page := range pages {
+				movies, err := fetcher(ctx, page)
+				results[page-first] = PageResult{Page: page, Movies: movies, Err: err}
+			}
+		}()
+	}
+
+	feed(ctx, pages, first, last)
+	group.Wait()
+
+	return dedupe(ctx, results, first)
+}
+
+func feed(ctx context.Context, pages chan<- int, first, last int) {
+	defer close(pages)
+
+	for page := first; page <= last; page++ {
+		select {
+		case pages <- page:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func dedupe(ctx context.Context, results []PageResult, first int) []PageResult {
+	seen := make(map[int]struct{})
+
+	for idx := range results {
+		result := &results[idx]
+
+		if result.Page == 0 {
+			result.Page = first + idx
+			result.Err = oops.In(service).Code(errResponse).Public("Cannot fetch data from API.").Wrap(ctx.Err())
+		}
+
+		movies := make([]Movie, 0, len(result.Movies))
+
+		for _, movie := range result.Movies {
+			if _, ok := seen[movie.ID]; ok && movie.ID != 0 {
+				continue
+			}
+
+			seen[movie.ID] = struct{}{}
+
+			movies = append(movies, movie)
+		}
+
+		result.Movies = movies
+	}
+
+	return results
+}
@@ -0,0 +1,247 @@
+package tmdb_test
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/mocks"
+)
+
+func TestTMDBAllMovies(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		all  func(obj *tmdb.TMDB, ctx context.Context, opts tmdb.PageOptions) iter.Seq2[tmdb.Movie, error]
+		path string
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{name: "playing", args: args{all: (*tmdb.TMDB).AllNowPlayingMovies, path: "/3/movie/now_playing"}},
+		{name: "popular", args: args{all: (*tmdb.TMDB).AllPopularMovies, path: "/3/movie/popular"}},
+		{name: "top", args: args{all: (*tmdb.TMDB).AllTopRatedMovies, path: "/3/movie/top_rated"}},
+		{name: "upcoming", args: args{all: (*tmdb.TMDB).AllUpcomingMovies, path: "/3/movie/upcoming"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			trans := mocks.NewMockRoundTripper(t)
+			pages(t, trans, test.args.path, 3, 1, 2, 3)
+
+			obj := New().SetTransport(trans)
+			got := collect(t, test.args.all(obj, t.Context(), tmdb.PageOptions{Start: 0, Limit: 0}))
+
+			assert.Equal(t, []string{"page1", "page2", "page3"}, got)
+		})
+	}
+}
+
+func TestTMDBAllMoviesOptions(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		opts  tmdb.PageOptions
+		total int
+	}
+
+	tests := []struct {
+		name  string
+		want  []string
+		pages []int
+		args  args
+	}{
+		{
+			name:  "start",
+			args:  args{opts: tmdb.PageOptions{Start: 2, Limit: 0}, total: 3},
+			pages: []int{2, 3},
+			want:  []string{"page2", "page3"},
+		},
+		{
+			name:  "limit",
+			args:  args{opts: tmdb.PageOptions{Start: 0, Limit: 2}, total: 3},
+			pages: []int{1, 2},
+			want:  []string{"page1", "page2"},
+		},
+		{
+			name:  "empty",
+			args:  args{opts: tmdb.PageOptions{Start: 0, Limit: 0}, total: 0},
+			pages: []int{1},
+			want:  []string{"page1"},
+		},
+		{
+			name:  "capped",
+			args:  args{opts: tmdb.PageOptions{Start: 499, Limit: 10}, total: 1000},
+			pages: []int{499, 500},
+			want:  []string{"page499", "page500"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			trans := mocks.NewMockRoundTripper(t)
+			pages(t, trans, "/3/movie/popular", test.args.total, test.pages...)
+
+			obj := New().SetTransport(trans)
+			got := collect(t, obj.AllPopularMovies(t.Context(), test.args.opts))
+
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestTMDBAllMoviesBreak(t *testing.T) {
+	t.Parallel()
+
+	trans := mocks.NewMockRoundTripper(t)
+	pages(t, trans, "/3/movie/popular", 3, 1)
+
+	obj := New().SetTransport(trans)
+
+	for movie, err := range obj.AllPopularMovies(t.Context(), tmdb.PageOptions{Start: 0, Limit: 0}) {
+		require.NoError(t, err)
+		assert.Equal(t, "page1", movie.Title)
+
+		break
+	}
+}
+
+func TestTMDBAllMoviesFailure(t *testing.T) {
+	t.Parallel()
+
+	trans := mocks.NewMockRoundTripper(t)
+	pages(t, trans, "/3/movie/popular", 3, 1)
+	trans.On("RoundTrip", mock.Anything).Return(nil, errFail).Once()
+
+	obj := New().SetTransport(trans)
+	titles := make([]string, 0)
+
+	var failed error
+
+	for movie, err := range obj.AllPopularMovies(t.Context(), tmdb.PageOptions{Start: 0, Limit: 0}) {
+		if err != nil {
+			failed = err
+
+			continue
+		}
+
+		titles = append(titles, movie.Title)
+	}
+
+	var orr oops.OopsError
+
+	require.ErrorAs(t, failed, &orr)
+
+	assert.Equal(t, "Cannot fetch data from API.", orr.Public())
+	assert.Equal(t, []string{"page1"}, titles)
+}
+
+func pages(t *testing.T, trans *mocks.MockRoundTripper, path string, total int, pages ...int) {
+	t.Helper()
+
+	for _, page := range pages {
+		trans.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+			return req.URL.Path == path && req.URL.Query().Get("page") == strconv.Itoa(page)
+		})).Return(pageResponse(t, page, total), nil).Once()
+	}
+}
+
+func pageResponse(t *testing.T, page, total int) *http.Response {
+	t.Helper()
+
+	return response(t, http.StatusOK, fmt.Sprintf(
+		`{"page": %d, "total_pages": %d, "results": [{"title": "page%d"}]}`,
+		page, total, page,
+	))
+}
+
+func collect(t *testing.T, seq iter.Seq2[tmdb.Movie, error]) []string {
+	t.Helper()
+
+	titles := make([]string, 0)
+
+	for movie, err := range seq {
+		require.NoError(t, err)
+
+		titles = append(titles, movie.Title)
+	}
+
+	return titles
+}
+
+func TestFetchPages(t *testing.T) {
+	t.Parallel()
+
+	fetcher := func(_ context.Context, page int) ([]tmdb.Movie, error) {
+		switch page {
+		case 2:
+			return nil, errFail
+		case 3:
+			return []tmdb.Movie{newMovie(1, "shifted"), newMovie(3, "three")}, nil
+		default:
+			return []tmdb.Movie{newMovie(page, "page"+strconv.Itoa(page))}, nil
+		}
+	}
+
+	got := tmdb.FetchPages(t.Context(), fetcher, 1, 4, 3)
+
+	require.Len(t, got, 4)
+
+	for idx, result := range got {
+		assert.Equal(t, idx+1, result.Page)
+	}
+
+	assert.Equal(t, []tmdb.Movie{newMovie(1, "page1")}, got[0].Movies)
+	require.ErrorIs(t, got[1].Err, errFail)
+	assert.Equal(t, []tmdb.Movie{newMovie(3, "three")}, got[2].Movies)
+	assert.Equal(t, []tmdb.Movie{newMovie(4, "page4")}, got[3].Movies)
+}
+
+func TestFetchPagesEmptyRange(t *testing.T) {
+	t.Parallel()
+
+	got := tmdb.FetchPages(t.Context(), nil, 5, 1, 1)
+
+	assert.Empty(t, got)
+}
+
+func TestFetchPagesCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	fetcher := func(ctx context.Context, page int) ([]tmdb.Movie, error) {
+		cancel()
+
+		return []tmdb.Movie{newMovie(page, "")}, ctx.Err()
+	}
+
+	got := tmdb.FetchPages(ctx, fetcher, 1, 10, 1)
+
+	require.Len(t, got, 10)
+
+	for idx, result := range got {
+		assert.Equal(t, idx+1, result.Page)
+		require.ErrorIs(t, result.Err, context.Canceled)
+
+		if len(result.Movies) == 0 {
+			// the pages never fetched get the error of the cancellation
+			assert.Equal(t, "Cannot fetch data from API.", oops.GetPublic(result.Err, ""))
+		}
+	}
+}
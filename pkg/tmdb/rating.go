@@ -0,0 +1,59 @@
+package tmdb
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+const (
+	pathRating = "/rating"
+
+	ratingMin  = 0.5
+	ratingMax  = 10
+	ratingStep = 0.5
+)
+
+// RateMovie rates the movie on behalf of Config.SessionID or Config.GuestSessionID. Values go from 0.5 to 10 in
+// steps of 0.5, rating again replaces the previous value.
+func (c *TMDB) RateMovie(ctx context.Context, movieID int, value float64) error {
+	if value < ratingMin || value > ratingMax || math.Mod(value, ratingStep) != 0 {
+		return c.oops.Code(errInvalidArgument).
+			With("value", value).
+			Public("Invalid rating: Values go from 0.5 to 10 in steps of 0.5.").
+			New("invalid rating")
+	}
+
+	return c.rating(ctx, http.MethodPost, "RateMovie", movieID, map[string]float64{"value": value})
+}
+
+func (c *TMDB) DeleteMovieRating(ctx context.Context, movieID int) error {
+	return c.rating(ctx, http.MethodDelete, "DeleteMovieRating", movieID, nil)
+}
+
+func (c *TMDB) rating(ctx context.Context, method, operation string, movieID int, body any) error {
+	var data errorResponse
+
+	params, err := c.session(true)
+	if err != nil {
+		return err
+	}
+
+	return c.send(ctx, method, operation, pathMovie+strconv.Itoa(movieID)+pathRating, params, body, &data)
+}
+
+// session returns the query parameters that authorise a call on behalf of a user, falling back to the guest
+// session when guest is allowed.
+func (c *TMDB) session(guest bool) (map[string]string, error) {
+	switch {
+	case c.config.SessionID != "":
+		return map[string]string{sessionParam: c.config.SessionID}, nil
+	case guest && c.config.GuestSessionID != "":
+		return map[string]string{guestSessionParam: c.config.GuestSessionID}, nil
+	default:
+		return nil, c.oops.Code(errInvalidArgument).
+			Public("Session required: Log in to act on behalf of a user.").
+			New("no session")
+	}
+}
@@ -0,0 +1,152 @@
+package tmdb_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
+)
+
+func TestTMDBRateMovie(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(loggedIn(t, server)))
+
+	require.NoError(t, obj.RateMovie(t.Context(), 550, 8.5))
+
+	value, rated := server.Rating(550)
+
+	assert.True(t, rated)
+	assert.InDelta(t, 8.5, value, 0)
+
+	require.NoError(t, obj.RateMovie(t.Context(), 550, 10))
+
+	value, _ = server.Rating(550)
+
+	assert.InDelta(t, 10, value, 0)
+
+	require.NoError(t, obj.DeleteMovieRating(t.Context(), 550))
+
+	_, rated = server.Rating(550)
+
+	assert.False(t, rated)
+
+	err := obj.DeleteMovieRating(t.Context(), 550)
+
+	assertPublic(t, err, "The resource you requested could not be found.")
+
+	err = obj.RateMovie(t.Context(), 13, 5)
+
+	assertPublic(t, err, "The resource you requested could not be found.")
+}
+
+func TestTMDBRateMovieAsGuest(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	config := server.Config()
+	config.GuestSessionID = fp.Must(fp.Must(tmdb.New(config)).CreateGuestSession(t.Context())).GuestSessionID
+
+	obj := fp.Must(tmdb.New(config))
+
+	require.NoError(t, obj.RateMovie(t.Context(), 550, 0.5))
+
+	value, ok := server.Rating(550)
+
+	assert.True(t, ok)
+	assert.InDelta(t, 0.5, value, 0)
+}
+
+func TestTMDBRateMovieFailure(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	anonymous := fp.Must(tmdb.New(server.Config()))
+
+	const noSession = "Session required: Log in to act on behalf of a user."
+
+	assertPublic(t, anonymous.RateMovie(t.Context(), 550, 8), noSession)
+	assertPublic(t, anonymous.DeleteMovieRating(t.Context(), 550), noSession)
+
+	config := server.Config()
+	config.SessionID = "expired"
+
+	expired := fp.Must(tmdb.New(config))
+
+	assertPublic(t, expired.RateMovie(t.Context(), 550, 8),
+		"Authentication failed: You do not have permissions to access the service.")
+
+	obj := fp.Must(tmdb.New(loggedIn(t, server)))
+
+	for _, value := range []float64{0, 0.3, 8.25, 10.5, -1} {
+		err := obj.RateMovie(t.Context(), 550, value)
+
+		assertPublic(t, err, "Invalid rating: Values go from 0.5 to 10 in steps of 0.5.")
+	}
+
+	assert.Equal(t, 1, server.Hits("/3/movie/550/rating"))
+}
+
+func TestTMDBRateMovieNotRetried(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t).Script(tmdbtest.Fault{
+		Path:   "/3/movie/550/rating",
+		Status: http.StatusServiceUnavailable,
+		Delay:  0,
+		Times:  1,
+	})
+	obj := fp.Must(tmdb.New(loggedIn(t, server)))
+
+	err := obj.RateMovie(t.Context(), 550, 7)
+
+	assertPublic(t, err, "Service offline: This service is temporarily offline, try again later.")
+	assert.Equal(t, 1, server.Hits("/3/movie/550/rating"))
+
+	_, ok := server.Rating(550)
+
+	assert.False(t, ok)
+}
+
+func TestTMDBRateMovieTransportFailure(t *testing.T) {
+	t.Parallel()
+
+	output := new(bytes.Buffer)
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.Anything).Return(nil, errFail).Once()
+
+	opts := &slog.HandlerOptions{AddSource: false, Level: slog.LevelDebug, ReplaceAttr: nil}
+	obj := fp.Must(tmdb.New(tmdb.Config{
+		Logger:         slog.New(slog.NewJSONHandler(output, opts)),
+		Tracing:        nil,
+		Metrics:        nil,
+		Breaker:        nil,
+		Timeout:        time.Minute,
+		Host:           "https://tmdb.host",
+		Token:          "secret",
+		Auth:           "",
+		SessionID:      "user-session",
+		GuestSessionID: "",
+		AccessToken:    "",
+		Language:       "",
+		Region:         "",
+	})).SetTransport(trans)
+
+	err := obj.RateMovie(t.Context(), 550, 7)
+
+	assertPublic(t, err, "Cannot reach API: The change may or may not have been applied.")
+	assert.NotContains(t, err.Error(), "user-session")
+	assert.Equal(t, map[string]any{"session_id": "REDACTED"}, record(t, output)["query"])
+	assert.NotContains(t, output.String(), "user-session")
+}
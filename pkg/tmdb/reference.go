@@ -0,0 +1,246 @@
+package tmdb
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+)
+
+const (
+	pathReference           = "/3/configuration/"
+	pathCountries           = pathReference + "countries"
+	pathLanguages           = pathReference + "languages"
+	pathPrimaryTranslations = pathReference + "primary_translations"
+	pathTimezones           = pathReference + "timezones"
+
+	defaultLanguage = "en"
+
+	// maxSuggestions and maxDistance bound the "did you mean" candidates of an unknown language or region.
+	maxSuggestions = 3
+	maxDistance    = 2
+)
+
+// candidate is a valid code with the names a user may type instead.
+type candidate struct {
+	code  string
+	names []string
+}
+
+func (c *TMDB) GetCountries(ctx context.Context) ([]Country, error) {
+	return reference[Country](ctx, c, "GetCountries", pathCountries)
+}
+
+func (c *TMDB) GetLanguages(ctx context.Context) ([]Language, error) {
+	return reference[Language](ctx, c, "GetLanguages", pathLanguages)
+}
+
+// GetPrimaryTranslations lists the `language-REGION` codes TMDB translates into, such as `pt-BR`.
+func (c *TMDB) GetPrimaryTranslations(ctx context.Context) ([]string, error) {
+	return reference[string](ctx, c, "GetPrimaryTranslations", pathPrimaryTranslations)
+}
+
+func (c *TMDB) GetTimezones(ctx context.Context) ([]Timezones, error) {
+	return reference[Timezones](ctx, c, "GetTimezones", pathTimezones)
+}
+
+// reference fetches the reference data at path once per client, it only changes with TMDB releases. Failures are
+// not kept, the next call tries again.
+func reference[T any](ctx context.Context, client *TMDB, operation, path string) ([]T, error) {
+	client.mutex.Lock()
+	cached, ok := client.references[path].([]T)
+	client.mutex.Unlock()
+
+	if ok {
+		return slices.Clone(cached), nil
+	}
+
+	var data []T
+
+	err := client.get(ctx, operation, path, make(map[string]string), &data)
+	if err != nil {
+		return nil, err
+	}
+
+	client.mutex.Lock()
+	client.references[path] = data
+	client.mutex.Unlock()
+
+	return slices.Clone(data), nil
+}
+
+// CheckLocale checks Config.Language and Config.Region against the reference data: TMDB answers an unknown language
+// in English and an unknown region with nothing. Call it once before the calls they apply to. A failure to fetch the
+// reference data can be retried, the data is only kept once fetched.
+func (c *TMDB) CheckLocale(ctx context.Context) error {
+	languages, err := c.languages(ctx)
+	if err != nil {
+		return err
+	}
+
+	regions, err := c.regions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = c.known("language", c.config.Language, languages); err != nil {
+		return err
+	}
+
+	return c.known("region", c.config.Region, regions)
+}
+
+// languages are the primary translations for `language-REGION` codes and the plain languages otherwise.
+func (c *TMDB) languages(ctx context.Context) ([]candidate, error) {
+	if c.config.Language == "" {
+		return nil, nil
+	}
+
+	if strings.Contains(c.config.Language, "-") {
+		codes, err := c.GetPrimaryTranslations(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		candidates := make([]candidate, 0, len(codes))
+
+		for _, code := range codes {
+			candidates = append(candidates, candidate{code: code, names: nil})
+		}
+
+		return candidates, nil
+	}
+
+	languages, err := c.GetLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]candidate, 0, len(languages))
+
+	for _, language := range languages {
+		names := []string{language.EnglishName, language.Name}
+		candidates = append(candidates, candidate{code: language.Code, names: names})
+	}
+
+	return candidates, nil
+}
+
+func (c *TMDB) regions(ctx context.Context) ([]candidate, error) {
+	if c.config.Region == "" {
+		return nil, nil
+	}
+
+	countries, err := c.GetCountries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]candidate, 0, len(countries))
+
+	for _, country := range countries {
+		names := []string{country.EnglishName, country.NativeName}
+		candidates = append(candidates, candidate{code: country.Code, names: names})
+	}
+
+	return candidates, nil
+}
+
+func (c *TMDB) known(what, value string, candidates []candidate) error {
+	if value == "" || slices.ContainsFunc(candidates, func(item candidate) bool { return item.code == value }) {
+		return nil
+	}
+
+	suggestions := suggest(value, candidates)
+	public := "Invalid " + what + " \"" + value + "\": "
+
+	if len(suggestions) > 0 {
+		public += "Did you mean " + strings.Join(suggestions, ", ") + "?"
+	} else {
+		public += "TMDB does not know it."
+	}
+
+	return c.oops.Code(errInvalidConfig).
+		With(what, value).
+		With("suggestions", suggestions).
+		Public(public).
+		New("unknown " + what)
+}
+
+// suggest returns the code spelled value in another case or named value first, otherwise the closest codes.
+func suggest(value string, candidates []candidate) []string {
+	for _, item := range candidates {
+		if strings.EqualFold(item.code, value) ||
+			slices.ContainsFunc(item.names, func(name string) bool { return strings.EqualFold(name, value) }) {
+			return []string{item.code}
+		}
+	}
+
+	type scored struct {
+		code     string
+		distance int
+	}
+
+	nearby := make([]scored, 0)
+	value = strings.ToLower(value)
+	// any two letter code is two edits away from any other, so short values allow fewer edits
+	limit := min(maxDistance, len(value)-1)
+
+	for _, item := range candidates {
+		distance := levenshtein(value, strings.ToLower(item.code))
+		if distance <= limit {
+			nearby = append(nearby, scored{code: item.code, distance: distance})
+		}
+	}
+
+	slices.SortFunc(nearby, func(a, b scored) int {
+		return cmp.Or(cmp.Compare(a.distance, b.distance), cmp.Compare(a.code, b.code))
+	})
+
+	suggestions := make([]string, 0, maxSuggestions)
+
+	for _, item := range nearby[:min(len(nearby), maxSuggestions)] {
+		suggestions = append(suggestions, item.code)
+	}
+
+	return suggestions
+}
+
+// levenshtein counts the single character edits turning one string into the other.
+func levenshtein(from, into string) int {
+	source, target := []rune(from), []rune(into)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+
+	for idx := range previous {
+		previous[idx] = idx
+	}
+
+	for row, char := range source {
+		current[0] = row + 1
+
+		for col, other := range target {
+			cost := 1
+			if char == other {
+				cost = 0
+			}
+
+			current[col+1] = min(previous[col+1]+1, current[col]+1, previous[col]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
+
+// localeParams are the client-wide parameters every call carries.
+func (c Config) localeParams() map[string]string {
+	params := map[string]string{"language": cmp.Or(c.Language, defaultLanguage)}
+
+	if c.Region != "" {
+		params["region"] = c.Region
+	}
+
+	return params
+}
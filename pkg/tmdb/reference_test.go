@@ -0,0 +1,150 @@
+package tmdb_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
+)
+
+func TestTMDBReference(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	countries, err := obj.GetCountries(t.Context())
+
+	require.NoError(t, err)
+	assert.Contains(t, countries, tmdb.Country{Code: "DE", EnglishName: "Germany", NativeName: "Deutschland"})
+
+	languages, err := obj.GetLanguages(t.Context())
+
+	require.NoError(t, err)
+	assert.Contains(t, languages, tmdb.Language{Code: "en", EnglishName: "English", Name: "English"})
+
+	translations, err := obj.GetPrimaryTranslations(t.Context())
+
+	require.NoError(t, err)
+	assert.Contains(t, translations, "en-GB")
+
+	timezones, err := obj.GetTimezones(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, "DE", timezones[0].Country)
+
+	countries[0].Code = "changed"
+
+	countries, err = obj.GetCountries(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, "DE", countries[0].Code)
+	assert.Equal(t, 1, server.Hits("/3/configuration/countries"))
+}
+
+func TestTMDBCheckLocale(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t)
+	obj := fp.Must(tmdb.New(withLocale(server, "de", "DE")))
+
+	movies, err := obj.GetPopularMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, popular(), movies)
+	assert.Zero(t, server.Hits("/3/configuration/languages"))
+
+	for range 2 {
+		require.NoError(t, obj.CheckLocale(t.Context()))
+	}
+
+	assert.Equal(t, 1, server.Hits("/3/configuration/languages"))
+	assert.Equal(t, 1, server.Hits("/3/configuration/countries"))
+	assert.Zero(t, server.Hits("/3/configuration/primary_translations"))
+
+	obj = fp.Must(tmdb.New(withLocale(server, "en-GB", "")))
+
+	require.NoError(t, obj.CheckLocale(t.Context()))
+	assert.Equal(t, 1, server.Hits("/3/configuration/primary_translations"))
+	assert.Equal(t, 1, server.Hits("/3/configuration/countries"))
+
+	require.NoError(t, fp.Must(tmdb.New(server.Config())).CheckLocale(t.Context()))
+	assert.Equal(t, 1, server.Hits("/3/configuration/languages"))
+}
+
+func TestTMDBCheckLocaleInvalid(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		language string
+		region   string
+	}
+
+	tests := []struct {
+		name string
+		want string
+		args args
+	}{
+		{
+			name: "close translation",
+			args: args{language: "en-UK", region: ""},
+			want: `Invalid language "en-UK": Did you mean en-US, en-GB?`,
+		},
+		{
+			name: "language case",
+			args: args{language: "EN", region: ""},
+			want: `Invalid language "EN": Did you mean en?`,
+		},
+		{
+			name: "language name",
+			args: args{language: "german", region: ""},
+			want: `Invalid language "german": Did you mean de?`,
+		},
+		{
+			name: "unknown language",
+			args: args{language: "zz", region: "DE"},
+			want: `Invalid language "zz": TMDB does not know it.`,
+		},
+		{
+			name: "region name",
+			args: args{language: "", region: "Deutschland"},
+			want: `Invalid region "Deutschland": Did you mean DE?`,
+		},
+		{
+			name: "unknown region",
+			args: args{language: "fr", region: "XX"},
+			want: `Invalid region "XX": TMDB does not know it.`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj := fp.Must(tmdb.New(withLocale(fixture(t), test.args.language, test.args.region)))
+
+			assertPublic(t, obj.CheckLocale(t.Context()), test.want)
+		})
+	}
+}
+
+func TestTMDBCheckLocaleReferenceFailure(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t).Script(tmdbtest.Fault{
+		Path:   "/3/configuration/languages",
+		Status: http.StatusServiceUnavailable,
+		Delay:  0,
+		Times:  1,
+	})
+	obj := fp.Must(tmdb.New(withLocale(server, "fr", "")))
+
+	require.Error(t, obj.CheckLocale(t.Context()))
+	require.NoError(t, obj.CheckLocale(t.Context()))
+	assert.Equal(t, 2, server.Hits("/3/configuration/languages"))
+}
@@ -1,57 +1,275 @@
 package tmdb
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	pathNowPlaying = "/3/movie/now_playing"
+	pathPopular    = "/3/movie/popular"
+	pathTopRated   = "/3/movie/top_rated"
+	pathUpcoming   = "/3/movie/upcoming"
+	pathMovie      = "/3/movie/"
+	pathLatest     = "/3/movie/latest"
+
+	AppendCredits         Append = "credits"
+	AppendVideos          Append = "videos"
+	AppendImages          Append = "images"
+	AppendKeywords        Append = "keywords"
+	AppendReleaseDates    Append = "release_dates"
+	AppendExternalIDs     Append = "external_ids"
+	AppendRecommendations Append = "recommendations"
+	AppendSimilar         Append = "similar"
+	AppendAltTitles       Append = "alternative_titles"
+	AppendTranslations    Append = "translations"
 
-	"resty.dev/v3"
+	snippetLimit = 512
+
+	headerAuthorization = "Authorization"
+	bearerPrefix        = "Bearer "
 )
 
-type moviesResponse struct {
-	Results []Movie `json:"results"`
+type (
+	// Append names a sub-resource returned together with the movie details via `append_to_response`.
+	Append string
+
+	DetailsOptions struct {
+		Append []Append
+	}
+)
+
+func Appends() []Append {
+	return []Append{
+		AppendCredits,
+		AppendVideos,
+		AppendImages,
+		AppendKeywords,
+		AppendReleaseDates,
+		AppendExternalIDs,
+		AppendRecommendations,
+		AppendSimilar,
+		AppendAltTitles,
+		AppendTranslations,
+	}
 }
 
 func (c *TMDB) GetNowPlayingMovies(ctx context.Context, page int) ([]Movie, error) {
-	return c.get(ctx, "/3/movie/now_playing", page)
+	return c.movies(ctx, "GetNowPlayingMovies", pathNowPlaying, page)
 }
 
 func (c *TMDB) GetPopularMovies(ctx context.Context, page int) ([]Movie, error) {
-	return c.get(ctx, "/3/movie/popular", page)
+	return c.movies(ctx, "GetPopularMovies", pathPopular, page)
 }
 
 func (c *TMDB) GetTopRatedMovies(ctx context.Context, page int) ([]Movie, error) {
-	return c.get(ctx, "/3/movie/top_rated", page)
+	return c.movies(ctx, "GetTopRatedMovies", pathTopRated, page)
 }
 
 func (c *TMDB) GetUpcomingMovies(ctx context.Context, page int) ([]Movie, error) {
-	return c.get(ctx, "/3/movie/upcoming", page)
+	return c.movies(ctx, "GetUpcomingMovies", pathUpcoming, page)
+}
+
+func (c *TMDB) GetMovieDetails(ctx context.Context, movieID int, opts DetailsOptions) (MovieDetails, error) {
+	var data MovieDetails
+
+	params := make(map[string]string)
+
+	if len(opts.Append) > 0 {
+		appends := make([]string, 0, len(opts.Append))
+
+		for _, item := range opts.Append {
+			if !slices.Contains(Appends(), item) {
+				return data, c.oops.Code(errInvalidArgument).
+					With("append", item).
+					Public("Unknown details append value.").
+					New("invalid append")
+			}
+
+			appends = append(appends, string(item))
+		}
+
+		params["append_to_response"] = strings.Join(appends, ",")
+	}
+
+	if slices.Contains(opts.Append, AppendImages) {
+		// the artwork in the configured language along with the artwork without text
+		params["include_image_language"] = cmp.Or(imageLanguage(c.config.Language), defaultLanguage) + "," + imageNull
+	}
+
+	return data, c.get(ctx, "GetMovieDetails", pathMovie+strconv.Itoa(movieID), params, &data)
+}
+
+// GetLatestMovie returns the movie most recently added to TMDB. It changes every few minutes.
+func (c *TMDB) GetLatestMovie(ctx context.Context) (MovieDetails, error) {
+	var data MovieDetails
+
+	return data, c.get(ctx, "GetLatestMovie", pathLatest, make(map[string]string), &data)
+}
+
+func (c *TMDB) movies(ctx context.Context, operation, path string, page int) ([]Movie, error) {
+	data, err := c.list(ctx, operation, path, page)
+
+	return data.Results, err
 }
 
-func (c *TMDB) get(ctx context.Context, path string, page int) ([]Movie, error) {
-	var data moviesResponse
+func (c *TMDB) list(ctx context.Context, operation, path string, page int) (MoviesPage, error) {
+	var data MoviesPage
+
+	return data, c.get(ctx, operation, path, map[string]string{"page": strconv.Itoa(page)}, &data)
+}
+
+func (c *TMDB) get(ctx context.Context, operation, path string, params map[string]string, result any) error {
+	return c.doer.Do(ctx, &Call{
+		Result:    result,
+		Body:      nil,
+		Query:     params,
+		Header:    make(http.Header),
+		Operation: operation,
+		Method:    http.MethodGet,
+		Path:      path,
+		Status:    0,
+		Code:      0,
+		Attempt:   0,
+		Latency:   0,
+		Shared:    false,
+	})
+}
+
+// send makes a call carrying a JSON body. Such calls change state on TMDB, so they are never coalesced.
+func (c *TMDB) send(
+	ctx context.Context, method, operation, path string, params map[string]string, body, result any,
+) error {
+	return c.doer.Do(ctx, &Call{
+		Result:    result,
+		Body:      body,
+		Query:     params,
+		Header:    make(http.Header),
+		Operation: operation,
+		Method:    method,
+		Path:      path,
+		Status:    0,
+		Code:      0,
+		Attempt:   0,
+		Latency:   0,
+		Shared:    false,
+	})
+}
+
+func (c *TMDB) do(ctx context.Context, call *Call) error {
+	start := time.Now()
+
+	reply, shared, err := c.share(ctx, call)
 
-	resp, err := c.engine.R().
+	call.Latency = time.Since(start)
+	call.Shared = shared
+	call.Status = reply.status
+	call.Attempt = reply.attempt
+
+	return c.parseResponse(call, reply, err)
+}
+
+func (c *TMDB) execute(ctx context.Context, call *Call) (reply, error) {
+	var data reply
+
+	req := c.engine.R().
 		SetContext(ctx).
-		SetResult(&data).
-		SetQueryParam("page", strconv.Itoa(page)).
-		Get(path)
+		SetQueryParams(call.Query).
+		SetHeaderMultiValues(call.Header)
 
-	return data.Results, c.parseResponse(resp, err)
+	if token, ok := strings.CutPrefix(call.Header.Get(headerAuthorization), bearerPrefix); ok {
+		// the v4 user access token of the call replaces the application token
+		req.SetAuthToken(token)
+	}
+
+	if call.Body != nil {
+		// TMDB expects the session id of DELETE /authentication/session in the body
+		req.SetBody(call.Body).SetAllowMethodDeletePayload(true)
+	}
+
+	if call.Method != http.MethodGet {
+		// a write may have been applied even when its response got lost, so it is never repeated
+		req.SetRetryCount(0)
+	}
+
+	resp, err := req.Execute(call.Method, call.Path)
+
+	if resp != nil {
+		data.header = resp.Header()
+		data.body = resp.Bytes()
+		data.status = resp.StatusCode()
+		data.attempt = resp.Request.Attempt
+	}
+
+	if err != nil && call.Method != http.MethodGet {
+		return data, c.oops.Code(errResponse).
+			With("method", call.Method).
+			Public("Cannot reach API: The change may or may not have been applied.").
+			Wrap(redactError(err))
+	}
+
+	if err != nil {
+		return data, c.fetchError(err)
+	}
+
+	return data, nil
 }
 
-func (c *TMDB) parseResponse(resp *resty.Response, err error) error {
-	errBuilder := c.oops.Code(errResponse)
+func (c *TMDB) fetchError(err error) error {
+	return c.oops.Code(errResponse).Public("Cannot fetch data from API.").Wrap(redactError(err))
+}
 
+func (c *TMDB) parseResponse(call *Call, reply reply, err error) error {
 	if err != nil {
-		return errBuilder.Public("Cannot fetch data from API.").Wrap(err)
+		return err
 	}
 
-	if status := resp.StatusCode(); status != http.StatusOK {
-		err, _ := resp.Error().(*errorResponse)
+	if !succeeded(call.Method, reply.status) {
+		return c.parseError(call, reply)
+	}
 
-		return errBuilder.With("status", status).Public(err.StatusMessage).New("invalid response")
+	if err = json.Unmarshal(reply.body, call.Result); err != nil {
+		return c.oops.Code(errResponse).
+			With("body", snippet(reply.body)).
+			Public("Unexpected response from API.").
+			Wrap(err)
 	}
 
 	return nil
 }
+
+func (c *TMDB) parseError(call *Call, reply reply) error {
+	var data errorResponse
+
+	errBuilder := c.oops.Code(errResponse).
+		With("status", reply.status).
+		With("contentType", reply.header.Get("Content-Type")).
+		With("body", snippet(reply.body))
+
+	if json.Unmarshal(reply.body, &data) != nil || data.StatusMessage == "" {
+		return errBuilder.Public("Unexpected response from API.").New("invalid response")
+	}
+
+	call.Code = data.StatusCode
+
+	return errBuilder.With("statusCode", data.StatusCode).Public(data.StatusMessage).New("invalid response")
+}
+
+// succeeded accepts 201 Created from writes only. Reads answer 200, anything else carries an error body.
+func succeeded(method string, status int) bool {
+	return status == http.StatusOK || method != http.MethodGet && status == http.StatusCreated
+}
+
+func snippet(body []byte) string {
+	if len(body) > snippetLimit {
+		body = body[:snippetLimit]
+	}
+
+	return strings.ToValidUTF8(strings.TrimSpace(string(body)), "")
+}
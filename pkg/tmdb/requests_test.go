@@ -2,18 +2,22 @@ package tmdb_test
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/samber/oops"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/therenotomorrow/tmdb/pkg/fp"
 	"github.com/therenotomorrow/tmdb/pkg/tmdb"
 	"github.com/therenotomorrow/tmdb/pkg/tmdb/mocks"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
 )
 
 var errFail = errors.New("fail")
@@ -23,12 +27,14 @@ func want() []tmdb.Movie {
 		Title:       "title1",
 		Overview:    "overview1",
 		ReleaseDate: "releaseDate1",
+		ID:          1,
 		Popularity:  12.34,
 		VoteCount:   100,
 	}, {
 		Title:       "title2",
 		Overview:    "overview2",
 		ReleaseDate: "releaseDate2",
+		ID:          2,
 		Popularity:  56.78,
 		VoteCount:   200,
 	}}
@@ -230,15 +236,161 @@ func TestTMDBGetUpcomingMoviesError(t *testing.T) {
 	assert.Empty(t, got)
 }
 
+func TestTMDBAPIKeySuccess(t *testing.T) {
+	t.Parallel()
+
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.Query().Get("api_key") == "0123456789abcdef0123456789abcdef" &&
+			req.Header.Get("Authorization") == ""
+	})).Return(successResponse(t), nil)
+
+	obj := apiKeyClient().SetTransport(trans)
+	got, err := obj.GetPopularMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, want(), got)
+}
+
+func TestTMDBAPIKeyFailureRedacted(t *testing.T) {
+	t.Parallel()
+
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.Anything).Return(nil, errFail)
+
+	obj := apiKeyClient().SetTransport(trans)
+	got, err := obj.GetPopularMovies(t.Context(), 1)
+
+	var orr oops.OopsError
+
+	require.ErrorAs(t, err, &orr)
+	require.EqualError(
+		t,
+		err,
+		`Get "https://tmdb.host/3/movie/popular?api_key=REDACTED&language=en&page=1": fail`,
+	)
+
+	assert.NotContains(t, fmt.Sprintf("%+v", err), "0123456789abcdef0123456789abcdef")
+	assert.Empty(t, got)
+}
+
+func apiKeyClient() *tmdb.TMDB {
+	return fp.Must(tmdb.New(tmdb.Config{
+		Logger:         nil,
+		Tracing:        nil,
+		Metrics:        nil,
+		Breaker:        nil,
+		Timeout:        time.Minute,
+		Host:           "https://tmdb.host",
+		Token:          "0123456789abcdef0123456789abcdef",
+		Auth:           "",
+		SessionID:      "",
+		GuestSessionID: "",
+		AccessToken:    "",
+		Language:       "",
+		Region:         "",
+	}))
+}
+
+func TestTMDBGetPopularMoviesErrorBody(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		contentType string
+		body        string
+		code        int
+	}
+
+	tests := []struct {
+		name   string
+		public string
+		body   string
+		args   args
+	}{
+		{
+			name:   "html page",
+			args:   args{code: http.StatusBadGateway, contentType: "text/html", body: "<html>Bad Gateway</html>"},
+			public: "Unexpected response from API.",
+			body:   "<html>Bad Gateway</html>",
+		},
+		{
+			name:   "empty body",
+			args:   args{code: http.StatusInternalServerError, contentType: "", body: ""},
+			public: "Unexpected response from API.",
+			body:   "",
+		},
+		{
+			name:   "malformed json",
+			args:   args{code: http.StatusServiceUnavailable, contentType: "application/json", body: `{"status_`},
+			public: "Unexpected response from API.",
+			body:   `{"status_`,
+		},
+		{
+			name:   "unknown json",
+			args:   args{code: http.StatusNotFound, contentType: "application/json", body: `{"error":"nope"}`},
+			public: "Unexpected response from API.",
+			body:   `{"error":"nope"}`,
+		},
+		{
+			name:   "huge body",
+			args:   args{code: http.StatusBadGateway, contentType: "text/plain", body: strings.Repeat("x", 4096)},
+			public: "Unexpected response from API.",
+			body:   strings.Repeat("x", 512),
+		},
+		{
+			name:   "no content",
+			args:   args{code: http.StatusNoContent, contentType: "application/json", body: ""},
+			public: "Unexpected response from API.",
+			body:   "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			trans := mocks.NewMockRoundTripper(t)
+			trans.On("RoundTrip", mock.Anything).
+				Return(rawResponse(t, test.args.code, test.args.contentType, test.args.body), nil)
+
+			obj := New().SetTransport(trans)
+
+			var (
+				got []tmdb.Movie
+				err error
+			)
+
+			require.NotPanics(t, func() { got, err = obj.GetPopularMovies(t.Context(), 1) })
+
+			var orr oops.OopsError
+
+			require.ErrorAs(t, err, &orr)
+			require.EqualError(t, err, "invalid response")
+
+			assert.Equal(t, test.public, orr.Public())
+			assert.Equal(t, test.args.code, orr.Context()["status"])
+			assert.Equal(t, test.args.contentType, orr.Context()["contentType"])
+			assert.Equal(t, test.body, orr.Context()["body"])
+			assert.Empty(t, got)
+		})
+	}
+}
+
 func response(t *testing.T, code int, json string) *http.Response {
 	t.Helper()
 
+	return rawResponse(t, code, "application/json", json)
+}
+
+func rawResponse(t *testing.T, code int, contentType, body string) *http.Response {
+	t.Helper()
+
 	resp := new(http.Response)
 
 	resp.StatusCode = code
 	resp.Header = make(http.Header)
-	resp.Header.Set("Content-Type", "application/json")
-	resp.Body = io.NopCloser(strings.NewReader(json))
+	resp.Header.Set("Content-Type", contentType)
+	resp.Body = io.NopCloser(strings.NewReader(body))
 
 	return resp
 }
@@ -250,6 +402,7 @@ func successResponse(t *testing.T) *http.Response {
 {
   "results": [
     {
+      "id": 1,
       "title": "title1",
       "overview": "overview1",
       "release_date": "releaseDate1",
@@ -257,6 +410,7 @@ func successResponse(t *testing.T) *http.Response {
       "vote_count": 100
     },
     {
+      "id": 2,
       "title": "title2",
       "overview": "overview2",
       "release_date": "releaseDate2",
@@ -278,3 +432,144 @@ func failureResponse(t *testing.T) *http.Response {
 }
 `)
 }
+
+func TestTMDBGetMovieDetailsSuccess(t *testing.T) {
+	t.Parallel()
+
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		query := req.URL.Query()
+
+		return req.URL.Path == "/3/movie/550" &&
+			query.Get("append_to_response") == "credits,images,recommendations" &&
+			query.Get("include_image_language") == "en,null"
+	})).Return(detailsResponse(t), nil)
+
+	obj := New().SetTransport(trans)
+	got, err := obj.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{
+		Append: []tmdb.Append{tmdb.AppendCredits, tmdb.AppendImages, tmdb.AppendRecommendations},
+	})
+
+	require.NoError(t, err)
+
+	assert.Equal(t, 550, got.ID)
+	assert.Equal(t, "Fight Club", got.Title)
+	assert.Equal(t, 139, got.Runtime)
+	assert.Equal(t, []tmdb.Genre{{ID: 18, Name: "Drama"}}, got.Genres)
+	assert.Equal(t, &tmdb.Credits{
+		Cast: []tmdb.Cast{{ID: 819, Name: "Edward Norton", Character: "The Narrator", Order: 0}},
+		Crew: []tmdb.Crew{{ID: 7467, Name: "David Fincher", Job: "Director", Department: "Directing"}},
+	}, got.Credits)
+	assert.Equal(t, []tmdb.Image{{
+		FilePath:    "/poster.jpg",
+		Language:    "en",
+		AspectRatio: 0.667,
+		VoteAverage: 5.3,
+		VoteCount:   0,
+		Width:       1000,
+		Height:      1500,
+	}}, got.Images.Posters)
+	assert.Empty(t, got.Images.Backdrops)
+	assert.Equal(t, 1, got.Recommendations.TotalPages)
+	assert.Equal(t, "Se7en", got.Recommendations.Results[0].Title)
+	assert.Nil(t, got.Videos)
+	assert.Nil(t, got.Keywords)
+	assert.Nil(t, got.ReleaseDates)
+	assert.Nil(t, got.ExternalIDs)
+	assert.Nil(t, got.Similar)
+}
+
+func TestTMDBGetMovieDetailsNoAppend(t *testing.T) {
+	t.Parallel()
+
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "https://tmdb.host/3/movie/550?language=en"
+	})).Return(response(t, http.StatusOK, `{"id": 550, "title": "Fight Club"}`), nil)
+
+	obj := New().SetTransport(trans)
+	got, err := obj.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{Append: nil})
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "Fight Club", got.Title)
+	assert.Nil(t, got.Credits)
+}
+
+func TestTMDBGetLatestMovie(t *testing.T) {
+	t.Parallel()
+
+	server := fixture(t).
+		Script(tmdbtest.Fault{Path: "/3/movie/latest", Status: http.StatusNotFound, Delay: 0, Times: 1})
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	_, err := obj.GetLatestMovie(t.Context())
+	assertPublic(t, err, "The resource you requested could not be found.")
+
+	got, err := obj.GetLatestMovie(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, 550, got.ID)
+	assert.Equal(t, "Fight Club", got.Title)
+}
+
+func TestTMDBGetMovieDetailsInvalidAppend(t *testing.T) {
+	t.Parallel()
+
+	obj := New().SetTransport(mocks.NewMockRoundTripper(t))
+	got, err := obj.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{Append: []tmdb.Append{"reviews"}})
+
+	var orr oops.OopsError
+
+	require.ErrorAs(t, err, &orr)
+	require.EqualError(t, err, "invalid append")
+
+	assert.Equal(t, "Unknown details append value.", orr.Public())
+	assert.Empty(t, got)
+}
+
+func TestTMDBGetMovieDetailsError(t *testing.T) {
+	t.Parallel()
+
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.Anything).Return(failureResponse(t), nil)
+
+	obj := New().SetTransport(trans)
+	got, err := obj.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{Append: nil})
+
+	var orr oops.OopsError
+
+	require.ErrorAs(t, err, &orr)
+	require.EqualError(t, err, "invalid response")
+
+	assert.Equal(t, "Some public message.", orr.Public())
+	assert.Empty(t, got)
+}
+
+func detailsResponse(t *testing.T) *http.Response {
+	t.Helper()
+
+	return response(t, http.StatusOK, `
+{
+  "id": 550,
+  "title": "Fight Club",
+  "runtime": 139,
+  "genres": [{"id": 18, "name": "Drama"}],
+  "credits": {
+    "cast": [{"id": 819, "name": "Edward Norton", "character": "The Narrator", "order": 0}],
+    "crew": [{"id": 7467, "name": "David Fincher", "job": "Director", "department": "Directing"}]
+  },
+  "images": {
+    "posters": [{
+      "file_path": "/poster.jpg",
+      "iso_639_1": "en",
+      "aspect_ratio": 0.667,
+      "vote_average": 5.3,
+      "width": 1000,
+      "height": 1500
+    }]
+  },
+  "recommendations": {"page": 1, "total_pages": 1, "results": [{"id": 807, "title": "Se7en"}]}
+}
+`)
+}
@@ -0,0 +1,131 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/samber/oops"
+)
+
+const (
+	pathSearchMulti  = "/3/search/multi"
+	pathSearchMovie  = "/3/search/movie"
+	pathSearchTV     = "/3/search/tv"
+	pathSearchPerson = "/3/search/person"
+)
+
+// SearchMulti searches movies, TV shows and people at once, the most popular first whatever they are.
+func (c *TMDB) SearchMulti(ctx context.Context, query string, page int) (Page[SearchResult], error) {
+	return search[SearchResult](ctx, c, "SearchMulti", pathSearchMulti, query, page)
+}
+
+func (c *TMDB) SearchMovies(ctx context.Context, query string, page int) (MoviesPage, error) {
+	return search[Movie](ctx, c, "SearchMovies", pathSearchMovie, query, page)
+}
+
+func (c *TMDB) SearchTV(ctx context.Context, query string, page int) (Page[TVShow], error) {
+	return search[TVShow](ctx, c, "SearchTV", pathSearchTV, query, page)
+}
+
+func (c *TMDB) SearchPeople(ctx context.Context, query string, page int) (Page[Person], error) {
+	return search[Person](ctx, c, "SearchPeople", pathSearchPerson, query, page)
+}
+
+func search[T any](ctx context.Context, client *TMDB, operation, path, query string, page int) (Page[T], error) {
+	var data Page[T]
+
+	if query == "" {
+		return data, client.invalidArgument("query", "Search query is required.")
+	}
+
+	params := map[string]string{"query": query, "page": strconv.Itoa(page)}
+
+	return data, client.get(ctx, operation, path, params, &data)
+}
+
+// Name is the title of the movie, or the name of the TV show or the person.
+func (r SearchResult) Name() string {
+	switch {
+	case r.Movie != nil:
+		return r.Movie.Title
+	case r.TVShow != nil:
+		return r.TVShow.Name
+	case r.Person != nil:
+		return r.Person.Name
+	}
+
+	return ""
+}
+
+func (r SearchResult) ID() int {
+	switch {
+	case r.Movie != nil:
+		return r.Movie.ID
+	case r.TVShow != nil:
+		return r.TVShow.ID
+	case r.Person != nil:
+		return r.Person.ID
+	}
+
+	return 0
+}
+
+// UnmarshalJSON decodes the result into the type its `media_type` names.
+func (r *SearchResult) UnmarshalJSON(data []byte) error {
+	var tag struct {
+		MediaType MediaType `json:"media_type"`
+	}
+
+	err := json.Unmarshal(data, &tag)
+	if err != nil {
+		return oops.Wrap(err)
+	}
+
+	*r = SearchResult{Movie: nil, TVShow: nil, Person: nil, MediaType: tag.MediaType}
+
+	var value any
+
+	switch tag.MediaType {
+	case MediaMovie:
+		r.Movie = new(Movie)
+		value = r.Movie
+	case MediaTV:
+		r.TVShow = new(TVShow)
+		value = r.TVShow
+	case MediaPerson:
+		r.Person = new(Person)
+		value = r.Person
+	default:
+		return nil
+	}
+
+	return oops.Wrap(json.Unmarshal(data, value))
+}
+
+// MarshalJSON encodes the result the way TMDB does, its fields along with `media_type`.
+func (r SearchResult) MarshalJSON() ([]byte, error) {
+	var value any
+
+	switch {
+	case r.Movie != nil:
+		value = struct {
+			Movie
+			MediaType MediaType `json:"media_type"`
+		}{Movie: *r.Movie, MediaType: MediaMovie}
+	case r.TVShow != nil:
+		value = struct {
+			TVShow
+			MediaType MediaType `json:"media_type"`
+		}{TVShow: *r.TVShow, MediaType: MediaTV}
+	case r.Person != nil:
+		value = struct {
+			Person
+			MediaType MediaType `json:"media_type"`
+		}{Person: *r.Person, MediaType: MediaPerson}
+	default:
+		value = map[string]MediaType{"media_type": r.MediaType}
+	}
+
+	return oops.Wrap2(json.Marshal(value))
+}
@@ -0,0 +1,86 @@
+package tmdb_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func TestTMDBSearchMulti(t *testing.T) {
+	t.Parallel()
+
+	obj := fp.Must(tmdb.New(fixture(t).Config()))
+
+	got, err := obj.SearchMulti(t.Context(), "bad", 1)
+
+	require.NoError(t, err)
+	require.Len(t, got.Results, 2)
+	assert.Equal(t, tmdb.MediaMovie, got.Results[0].MediaType)
+	assert.Equal(t, "Bad Boys", got.Results[0].Name())
+	assert.Equal(t, tmdb.MediaTV, got.Results[1].MediaType)
+	assert.Equal(t, 1396, got.Results[1].ID())
+	assert.Nil(t, got.Results[1].Movie)
+
+	got, err = obj.SearchMulti(t.Context(), "cranston", 1)
+
+	require.NoError(t, err)
+	require.Len(t, got.Results, 1)
+	require.NotNil(t, got.Results[0].Person)
+	assert.Equal(t, "Breaking Bad", got.Results[0].Person.KnownFor[0].Name())
+
+	_, err = obj.SearchMulti(t.Context(), "", 1)
+	assertPublic(t, err, "Search query is required.")
+}
+
+func TestTMDBSearchByType(t *testing.T) {
+	t.Parallel()
+
+	obj := fp.Must(tmdb.New(fixture(t).Config()))
+
+	movies, err := obj.SearchMovies(t.Context(), "bad", 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.Movie{newMovie(1, "Bad Boys")}, movies.Results)
+
+	shows, err := obj.SearchTV(t.Context(), "bad", 1)
+
+	require.NoError(t, err)
+	require.Len(t, shows.Results, 1)
+	assert.Equal(t, "Breaking Bad", shows.Results[0].Name)
+
+	people, err := obj.SearchPeople(t.Context(), "bad", 1)
+
+	require.NoError(t, err)
+	assert.Empty(t, people.Results)
+	assert.Equal(t, 1, people.Page)
+
+	_, err = obj.SearchPeople(t.Context(), "", 1)
+	assertPublic(t, err, "Search query is required.")
+}
+
+func TestSearchResultJSON(t *testing.T) {
+	t.Parallel()
+
+	var result tmdb.SearchResult
+
+	require.NoError(t, json.Unmarshal([]byte(`{"media_type":"collection","id":10}`), &result))
+	assert.Equal(t, tmdb.MediaType("collection"), result.MediaType)
+	assert.Zero(t, result.ID())
+	assert.Empty(t, result.Name())
+
+	person := cranston()
+
+	data, err := json.Marshal(tmdb.SearchResult{Movie: nil, TVShow: nil, Person: &person, MediaType: tmdb.MediaPerson})
+
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &result))
+	assert.Equal(t, 17419, result.ID())
+	assert.Equal(t, tmdb.MediaPerson, result.MediaType)
+
+	require.Error(t, json.Unmarshal([]byte(`{"media_type":"movie","id":"550"}`), &result))
+}
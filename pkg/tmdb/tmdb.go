@@ -2,21 +2,36 @@ package tmdb
 
 import (
 	"context"
+	"errors"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"sync"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/trace"
 	"resty.dev/v3"
 )
 
 const (
 	service = "tmdb.TMDB"
 
-	errInvalidConfig = "invalidConfig"
-	errUnexpected    = "unexpectedError"
-	errResponse      = "responseError"
+	errInvalidConfig   = "invalidConfig"
+	errUnexpected      = "unexpectedError"
+	errResponse        = "responseError"
+	errInvalidArgument = "invalidArgument"
+
+	AuthBearer AuthMode = "bearer"
+	AuthAPIKey AuthMode = "api_key"
+
+	apiKeyParam       = "api_key"
+	sessionParam      = "session_id"
+	guestSessionParam = "guest_session_id"
+	apiKeyLength      = 32
+	redacted          = "REDACTED"
 )
 
 type (
@@ -25,20 +40,97 @@ type (
 		GetPopularMovies(ctx context.Context, page int) ([]Movie, error)
 		GetTopRatedMovies(ctx context.Context, page int) ([]Movie, error)
 		GetUpcomingMovies(ctx context.Context, page int) ([]Movie, error)
+		GetMovieDetails(ctx context.Context, movieID int, opts DetailsOptions) (MovieDetails, error)
+		GetLatestMovie(ctx context.Context) (MovieDetails, error)
+		GetAlternativeTitles(ctx context.Context, movieID int, country string) ([]AltTitle, error)
+		GetTranslations(ctx context.Context, movieID int) ([]Translation, error)
+		GetTVShowDetails(ctx context.Context, seriesID int) (TVShowDetails, error)
+		GetTVSeason(ctx context.Context, seriesID, season int) (Season, error)
+		GetTVEpisode(ctx context.Context, seriesID, season, episode int) (Episode, error)
+		GetAggregateCredits(ctx context.Context, seriesID int) (AggregateCredits, error)
+		SearchMulti(ctx context.Context, query string, page int) (Page[SearchResult], error)
+		SearchMovies(ctx context.Context, query string, page int) (MoviesPage, error)
+		SearchTV(ctx context.Context, query string, page int) (Page[TVShow], error)
+		SearchPeople(ctx context.Context, query string, page int) (Page[Person], error)
+		GetMovieImages(ctx context.Context, movieID int, opts ImageOptions) (Images, error)
+		GetPersonImages(ctx context.Context, personID int) ([]Image, error)
+		CreateRequestToken(ctx context.Context) (RequestToken, error)
+		CreateSession(ctx context.Context, requestToken string) (Session, error)
+		CreateSessionFromV4(ctx context.Context, accessToken string) (Session, error)
+		CreateGuestSession(ctx context.Context) (GuestSession, error)
+		DeleteSession(ctx context.Context, sessionID string) error
+		RateMovie(ctx context.Context, movieID int, value float64) error
+		DeleteMovieRating(ctx context.Context, movieID int) error
+		GetAccount(ctx context.Context) (Account, error)
+		SetFavorite(ctx context.Context, media MediaType, mediaID int, favorite bool) error
+		SetWatchlist(ctx context.Context, media MediaType, mediaID int, watchlist bool) error
+		GetFavoriteMovies(ctx context.Context, page int) (MoviesPage, error)
+		GetFavoriteTV(ctx context.Context, page int) (Page[TVShow], error)
+		GetWatchlistMovies(ctx context.Context, page int) (MoviesPage, error)
+		GetWatchlistTV(ctx context.Context, page int) (Page[TVShow], error)
+		GetRatedMovies(ctx context.Context, page int) (Page[RatedMovie], error)
+		GetRatedTV(ctx context.Context, page int) (Page[RatedTVShow], error)
+		GetAccountStates(ctx context.Context, movieID int) (AccountStates, error)
+		CreateAccessRequest(ctx context.Context) (AccessRequest, error)
+		CreateAccessToken(ctx context.Context, requestToken string) (AccessToken, error)
+		DeleteAccessToken(ctx context.Context, accessToken string) error
+		CreateList(ctx context.Context, opts ListOptions) (int, error)
+		UpdateList(ctx context.Context, listID int, opts ListOptions) error
+		GetList(ctx context.Context, listID int, page int) (List, error)
+		AddListItems(ctx context.Context, listID int, items []ListEntry) error
+		UpdateListItems(ctx context.Context, listID int, items []ListEntry) error
+		RemoveListItems(ctx context.Context, listID int, items []ListEntry) error
+		ClearList(ctx context.Context, listID int) error
+		DeleteList(ctx context.Context, listID int) error
+		GetChangedMovies(ctx context.Context, opts ChangesOptions) (Page[ChangedItem], error)
+		GetChangedTV(ctx context.Context, opts ChangesOptions) (Page[ChangedItem], error)
+		GetChangedPeople(ctx context.Context, opts ChangesOptions) (Page[ChangedItem], error)
+		GetMovieChanges(ctx context.Context, movieID int, opts ChangesOptions) ([]Change, error)
+		GetTVChanges(ctx context.Context, seriesID int, opts ChangesOptions) ([]Change, error)
+		GetPersonChanges(ctx context.Context, personID int, opts ChangesOptions) ([]Change, error)
+		GetCountries(ctx context.Context) ([]Country, error)
+		GetLanguages(ctx context.Context) ([]Language, error)
+		GetPrimaryTranslations(ctx context.Context) ([]string, error)
+		GetTimezones(ctx context.Context) ([]Timezones, error)
+		CheckLocale(ctx context.Context) error
 		io.Closer
 	}
 
+	// AuthMode selects how Config.Token is sent: as a v4 bearer token or as a v3 `api_key` query parameter.
+	AuthMode string
+
 	Config struct {
 		Host    string        `validate:"required,url"`
 		Token   string        `validate:"required"`
+		Auth    AuthMode      `validate:"omitempty,oneof=bearer api_key"`
 		Timeout time.Duration `validate:"required,min=5s"`
-		Debug   bool
+		Logger  *slog.Logger
+		Tracing trace.TracerProvider
+		Metrics Metrics
+		Breaker *Breaker
+		// SessionID of a logged in user, or GuestSessionID, authorises calls acting on behalf of a user. The user
+		// session wins when both are set.
+		SessionID      string
+		GuestSessionID string
+		// AccessToken is a v4 user access token. It authorises the v4 list calls instead of Token.
+		AccessToken string
+		// Language, `en` by default, and Region localise the responses. TMDB.CheckLocale checks both against the
+		// reference data, so a typo fails instead of quietly falling back to English or to no results.
+		Language string
+		Region   string
 	}
 
 	TMDB struct {
-		oops   oops.OopsErrorBuilder
-		engine *resty.Client
-		config Config
+		oops        oops.OopsErrorBuilder
+		doer        Doer
+		tracer      trace.Tracer
+		engine      *resty.Client
+		flights     map[string]*flight
+		references  map[string]any
+		middlewares []Middleware
+		config      Config
+		accountID   int
+		mutex       sync.Mutex
 	}
 
 	errorResponse struct {
@@ -54,18 +146,79 @@ func New(config Config) (*TMDB, error) {
 		return nil, errBuilder.Code(errInvalidConfig).Public("Invalid configuration.").Wrap(err)
 	}
 
+	config.Auth = config.AuthMode()
+
 	engine := resty.New().
 		SetTimeout(config.Timeout).
-		SetAuthToken(config.Token).
 		SetBaseURL(config.Host).
-		SetDebug(config.Debug).
-		SetError(new(errorResponse)).
-		SetQueryParams(map[string]string{"language": "en"})
+		SetQueryParams(config.localeParams())
+
+	if config.Auth == AuthAPIKey {
+		engine.SetQueryParam(apiKeyParam, config.Token)
+	} else {
+		engine.SetAuthToken(config.Token)
+	}
+
+	client := &TMDB{
+		oops:        errBuilder,
+		doer:        nil,
+		tracer:      nil,
+		engine:      engine,
+		flights:     make(map[string]*flight),
+		references:  make(map[string]any),
+		middlewares: nil,
+		config:      config,
+		accountID:   0,
+		mutex:       sync.Mutex{},
+	}
+	client.chain()
+
+	if config.Tracing != nil {
+		client.tracer = config.Tracing.Tracer(tracerName)
+		client.SetTransport(engine.Client().Transport)
+		client.Use(tracing(client.tracer))
+	}
+
+	if config.Logger != nil {
+		client.Use(logging(config.Logger))
+	}
+
+	if config.Metrics != nil {
+		client.Use(metrics(config.Metrics))
+	}
+
+	if config.Breaker != nil {
+		client.Use(breaking(config.Breaker))
+	}
+
+	return client, nil
+}
+
+// AuthMode returns the configured mode or, when unset, guesses it from the token shape: v3 API keys are 32 hex
+// characters, anything else is treated as a v4 read access token.
+func (c Config) AuthMode() AuthMode {
+	if c.Auth != "" {
+		return c.Auth
+	}
+
+	if len(c.Token) != apiKeyLength {
+		return AuthBearer
+	}
 
-	return &TMDB{config: config, engine: engine, oops: errBuilder}, nil
+	for _, char := range c.Token {
+		if !('0' <= char && char <= '9' || 'a' <= char && char <= 'f') {
+			return AuthBearer
+		}
+	}
+
+	return AuthAPIKey
 }
 
 func (c *TMDB) SetTransport(rt http.RoundTripper) *TMDB {
+	if c.tracer != nil {
+		rt = &tracedTransport{next: rt, tracer: c.tracer}
+	}
+
 	c.engine = c.engine.SetTransport(rt)
 
 	return c
@@ -74,3 +227,44 @@ func (c *TMDB) SetTransport(rt http.RoundTripper) *TMDB {
 func (c *TMDB) Close() error {
 	return c.oops.Code(errUnexpected).Public("Cannot close client connections.").Wrap(c.engine.Close())
 }
+
+func redactError(err error) error {
+	var urlErr *url.Error
+
+	if errors.As(err, &urlErr) {
+		urlErr.URL = redactURL(urlErr.URL)
+	}
+
+	return err
+}
+
+func redactURL(raw string) string {
+	link, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+
+	query := link.Query()
+	found := false
+
+	for param := range query {
+		if secret(param) {
+			query.Set(param, redacted)
+
+			found = true
+		}
+	}
+
+	if !found {
+		return raw
+	}
+
+	link.RawQuery = query.Encode()
+
+	return link.String()
+}
+
+// secret reports whether a query parameter carries a credential that must not reach logs, traces or errors.
+func secret(param string) bool {
+	return param == apiKeyParam || param == sessionParam || param == guestSessionParam
+}
@@ -0,0 +1,240 @@
+package tmdbtest
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+// AccountID is the id of the account every user session of the fake belongs to.
+const AccountID = 1
+
+// SeedTV stores TV shows that can be added to the account favorites and watchlist.
+func (s *Server) SeedTV(shows ...tmdb.TVShow) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, show := range shows {
+		s.tv[show.ID] = show
+	}
+
+	return s
+}
+
+// Collection returns the ids in an account collection ("favorite" or "watchlist") of the media type, in the order
+// they were added.
+func (s *Server) Collection(collection string, media tmdb.MediaType) []int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return slices.Clone(s.collections[collection+"/"+string(media)])
+}
+
+func (s *Server) account(writer http.ResponseWriter, req *http.Request) {
+	if !s.user(req) {
+		unauthenticated(writer)
+
+		return
+	}
+
+	write(writer, http.StatusOK, tmdb.Account{
+		Username:     "tmdbtest",
+		Name:         "",
+		Language:     "en",
+		Country:      "US",
+		ID:           AccountID,
+		IncludeAdult: false,
+	})
+}
+
+func (s *Server) mark(writer http.ResponseWriter, req *http.Request) {
+	var body map[string]any
+
+	collection := req.PathValue("collection")
+	decoded := json.NewDecoder(req.Body).Decode(&body) == nil
+
+	media, _ := body["media_type"].(string)
+	mediaID, _ := body["media_id"].(float64)
+	value, valid := body[collection].(bool)
+
+	switch {
+	case !s.user(req):
+		unauthenticated(writer)
+
+		return
+	case req.PathValue("account") != strconv.Itoa(AccountID) || !s.known(tmdb.MediaType(media), int(mediaID)):
+		failure(writer, http.StatusNotFound)
+
+		return
+	case !decoded || !valid:
+		reject(writer, http.StatusBadRequest, codeInvalidParams, invalidParams)
+
+		return
+	}
+
+	key := collection + "/" + media
+
+	s.mutex.Lock()
+	index := slices.Index(s.collections[key], int(mediaID))
+
+	switch {
+	case value && index == -1:
+		s.collections[key] = append(s.collections[key], int(mediaID))
+	case !value && index != -1:
+		s.collections[key] = slices.Delete(s.collections[key], index, index+1)
+	}
+	s.mutex.Unlock()
+
+	switch {
+	case !value:
+		acknowledge(writer, http.StatusOK, codeDeleted, "The item/record was deleted successfully.")
+	case index != -1:
+		acknowledge(writer, http.StatusCreated, codeUpdated, "The item/record was updated successfully.")
+	default:
+		acknowledge(writer, http.StatusCreated, codeSuccess, "Success.")
+	}
+}
+
+func (s *Server) collection(writer http.ResponseWriter, req *http.Request) {
+	if !s.user(req) {
+		unauthenticated(writer)
+
+		return
+	}
+
+	if req.PathValue("account") != strconv.Itoa(AccountID) {
+		failure(writer, http.StatusNotFound)
+
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	collection := req.PathValue("collection")
+
+	switch req.PathValue("media") {
+	case "movies":
+		if collection == "rated" {
+			paginate(writer, req, s.ratedMovies())
+		} else {
+			paginate(writer, req, resolve(s.collections[collection+"/movie"], s.movies()))
+		}
+	case "tv":
+		if collection == "rated" {
+			paginate(writer, req, []tmdb.RatedTVShow{})
+		} else {
+			paginate(writer, req, resolve(s.collections[collection+"/tv"], s.tv))
+		}
+	default:
+		failure(writer, http.StatusNotFound)
+	}
+}
+
+func (s *Server) accountStates(writer http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	movieID, err := strconv.Atoi(req.PathValue("id"))
+
+	s.mutex.Lock()
+	_, user := s.sessions[query.Get("session_id")]
+	_, guest := s.guests[query.Get("guest_session_id")]
+	_, known := s.details[movieID]
+	value, rated := s.ratings[movieID]
+	favorite := user && slices.Contains(s.collections["favorite/movie"], movieID)
+	watchlist := user && slices.Contains(s.collections["watchlist/movie"], movieID)
+	s.mutex.Unlock()
+
+	switch {
+	case !user && !guest:
+		unauthenticated(writer)
+
+		return
+	case err != nil || !known:
+		failure(writer, http.StatusNotFound)
+
+		return
+	}
+
+	states := map[string]any{"id": movieID, "favorite": favorite, "watchlist": watchlist, "rated": false}
+	if rated {
+		states["rated"] = map[string]any{"value": value}
+	}
+
+	write(writer, http.StatusOK, states)
+}
+
+// user reports whether the request carries a live user session.
+func (s *Server) user(req *http.Request) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, ok := s.sessions[req.URL.Query().Get("session_id")]
+
+	return ok
+}
+
+func (s *Server) known(media tmdb.MediaType, mediaID int) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch media {
+	case tmdb.MediaMovie:
+		_, ok := s.movies()[mediaID]
+
+		return ok
+	case tmdb.MediaTV:
+		_, ok := s.tv[mediaID]
+
+		return ok
+	default:
+		return false
+	}
+}
+
+// movies indexes every seeded movie by id. The caller holds the mutex.
+func (s *Server) movies() map[int]tmdb.Movie {
+	movies := make(map[int]tmdb.Movie)
+
+	for _, list := range s.lists {
+		for _, movie := range list {
+			movies[movie.ID] = movie
+		}
+	}
+
+	for movieID, details := range s.details {
+		movies[movieID] = details.Movie
+	}
+
+	return movies
+}
+
+// ratedMovies lists the rated movies by id. The caller holds the mutex.
+func (s *Server) ratedMovies() []tmdb.RatedMovie {
+	movies := s.movies()
+	rated := make([]tmdb.RatedMovie, 0, len(s.ratings))
+
+	for _, movieID := range slices.Sorted(maps.Keys(s.ratings)) {
+		rated = append(rated, tmdb.RatedMovie{Movie: movies[movieID], Rating: s.ratings[movieID]})
+	}
+
+	return rated
+}
+
+func resolve[T any](ids []int, items map[int]T) []T {
+	resolved := make([]T, 0, len(ids))
+
+	for _, id := range ids {
+		resolved = append(resolved, items[id])
+	}
+
+	return resolved
+}
+
+func unauthenticated(writer http.ResponseWriter) {
+	reject(writer, http.StatusUnauthorized, codeAuthFailed,
+		"Authentication failed: You do not have permissions to access the service.")
+}
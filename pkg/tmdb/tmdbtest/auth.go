@@ -0,0 +1,246 @@
+package tmdbtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	codeInvalidParams  = 5
+	codeInvalidID      = 6
+	codeSessionDenied  = 17
+	codeInvalidRequest = 33
+
+	invalidParams = "Invalid parameters: Your request parameters are incorrect."
+
+	// AccessAccountID is the v4 account object id of every access token the fake issues.
+	AccessAccountID = "tmdbtest-account"
+
+	tokenLifetime = time.Hour
+	expiresLayout = "2006-01-02 15:04:05 MST"
+)
+
+// Approve marks a request token issued by the fake as approved by the user, as the TMDB approval page does.
+func (s *Server) Approve(requestToken string) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.tokens[requestToken]; ok {
+		s.tokens[requestToken] = true
+	}
+
+	return s
+}
+
+// Session reports whether sessionID is a live user session, created and not deleted yet.
+func (s *Server) Session(sessionID string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, ok := s.sessions[sessionID]
+
+	return ok
+}
+
+// Access reports whether accessToken is a live v4 user access token, created and not deleted yet.
+func (s *Server) Access(accessToken string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, ok := s.access[accessToken]
+
+	return ok
+}
+
+func (s *Server) requestToken(writer http.ResponseWriter, _ *http.Request) {
+	token := s.issue("request-token-")
+
+	s.mutex.Lock()
+	s.tokens[token] = false
+	s.mutex.Unlock()
+
+	write(writer, http.StatusOK, map[string]any{
+		"success":       true,
+		"expires_at":    expires(),
+		"request_token": token,
+	})
+}
+
+func (s *Server) sessionNew(writer http.ResponseWriter, req *http.Request) {
+	if s.redeem(writer, field(req, "request_token")) {
+		s.session(writer)
+	}
+}
+
+func (s *Server) sessionConvert(writer http.ResponseWriter, req *http.Request) {
+	if field(req, "access_token") == "" {
+		reject(writer, http.StatusBadRequest, codeInvalidParams, invalidParams)
+
+		return
+	}
+
+	s.session(writer)
+}
+
+func (s *Server) guestSession(writer http.ResponseWriter, _ *http.Request) {
+	guestID := s.issue("guest-session-")
+
+	s.mutex.Lock()
+	s.guests[guestID] = struct{}{}
+	s.mutex.Unlock()
+
+	write(writer, http.StatusOK, map[string]any{
+		"success":          true,
+		"expires_at":       expires(),
+		"guest_session_id": guestID,
+	})
+}
+
+func (s *Server) sessionDelete(writer http.ResponseWriter, req *http.Request) {
+	sessionID := field(req, "session_id")
+
+	s.mutex.Lock()
+	_, ok := s.sessions[sessionID]
+	delete(s.sessions, sessionID)
+	s.mutex.Unlock()
+
+	if !ok {
+		reject(writer, http.StatusNotFound, codeInvalidID, "Invalid id: The pre-requisite id is invalid or not found.")
+
+		return
+	}
+
+	write(writer, http.StatusOK, map[string]any{"success": true})
+}
+
+func (s *Server) accessRequest(writer http.ResponseWriter, _ *http.Request) {
+	token := s.issue("access-request-")
+
+	s.mutex.Lock()
+	s.tokens[token] = false
+	s.mutex.Unlock()
+
+	write(writer, http.StatusOK, map[string]any{
+		"success":        true,
+		"status_code":    codeSuccess,
+		"status_message": "Success.",
+		"request_token":  token,
+	})
+}
+
+func (s *Server) accessToken(writer http.ResponseWriter, req *http.Request) {
+	if !s.redeem(writer, field(req, "request_token")) {
+		return
+	}
+
+	token := s.issue("access-token-")
+
+	s.mutex.Lock()
+	s.access[token] = struct{}{}
+	s.mutex.Unlock()
+
+	write(writer, http.StatusOK, map[string]any{
+		"success":        true,
+		"status_code":    codeSuccess,
+		"status_message": "Success.",
+		"access_token":   token,
+		"account_id":     AccessAccountID,
+	})
+}
+
+func (s *Server) accessDelete(writer http.ResponseWriter, req *http.Request) {
+	token := field(req, "access_token")
+
+	s.mutex.Lock()
+	_, ok := s.access[token]
+	delete(s.access, token)
+	s.mutex.Unlock()
+
+	if !ok {
+		reject(writer, http.StatusNotFound, codeInvalidID, "Invalid id: The pre-requisite id is invalid or not found.")
+
+		return
+	}
+
+	acknowledge(writer, http.StatusOK, codeSuccess, "Success.")
+}
+
+// bearer returns the live v4 user access token the request carries.
+func (s *Server) bearer(req *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return "", false
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, live := s.access[token]
+
+	return token, live
+}
+
+func (s *Server) session(writer http.ResponseWriter) {
+	sessionID := s.issue("session-")
+
+	s.mutex.Lock()
+	s.sessions[sessionID] = struct{}{}
+	s.mutex.Unlock()
+
+	write(writer, http.StatusOK, map[string]any{"success": true, "session_id": sessionID})
+}
+
+// redeem consumes an approved request token. It writes the rejection and returns false for any other token.
+func (s *Server) redeem(writer http.ResponseWriter, token string) bool {
+	s.mutex.Lock()
+	approved, issued := s.tokens[token]
+
+	if approved {
+		delete(s.tokens, token)
+	}
+	s.mutex.Unlock()
+
+	switch {
+	case token == "":
+		reject(writer, http.StatusBadRequest, codeInvalidParams, invalidParams)
+	case !issued:
+		reject(writer, http.StatusUnauthorized, codeInvalidRequest,
+			"Invalid request token: The request token is either expired or invalid.")
+	case !approved:
+		reject(writer, http.StatusUnauthorized, codeSessionDenied, "Session denied.")
+	default:
+		return true
+	}
+
+	return false
+}
+
+func (s *Server) issue(prefix string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.serial++
+
+	return prefix + strconv.Itoa(s.serial)
+}
+
+func field(req *http.Request, name string) string {
+	var body map[string]any
+
+	_ = json.NewDecoder(req.Body).Decode(&body)
+
+	value, _ := body[name].(string)
+
+	return value
+}
+
+func expires() string {
+	return time.Now().UTC().Add(tokenLifetime).Format(expiresLayout)
+}
+
+func reject(writer http.ResponseWriter, status, code int, message string) {
+	write(writer, status, errorBody{StatusMessage: message, StatusCode: code})
+}
@@ -0,0 +1,308 @@
+package tmdbtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/samber/oops"
+)
+
+const (
+	ModeReplay Mode = "replay"
+	ModeRecord Mode = "record"
+
+	service  = "tmdbtest.Cassette"
+	redacted = "REDACTED"
+	filePerm = 0o600
+
+	errCassette = "cassetteError"
+)
+
+type (
+	// Mode selects whether a Cassette talks to the network and records, or answers from its file.
+	Mode string
+
+	// Cassette is an http.RoundTripper for TMDB.SetTransport. In ModeRecord it forwards requests and keeps every
+	// interaction until Save writes them to the file. In ModeReplay it answers from the file only. Requests match on
+	// method, path and query, with parameters sorted and credential values ignored, and other than GET on the body
+	// too. Credentials never reach the file, neither sent in a request nor issued in a response body.
+	Cassette struct {
+		next         http.RoundTripper
+		oops         oops.OopsErrorBuilder
+		path         string
+		mode         Mode
+		interactions []Interaction
+		replayed     []bool
+		mutex        sync.Mutex
+	}
+
+	Interaction struct {
+		Request  RecordedRequest  `json:"request"`
+		Response RecordedResponse `json:"response"`
+	}
+
+	RecordedRequest struct {
+		Header http.Header `json:"header"`
+		Method string      `json:"method"`
+		Path   string      `json:"path"`
+		Query  string      `json:"query"`
+		Body   string      `json:"body,omitempty"`
+	}
+
+	RecordedResponse struct {
+		Header http.Header `json:"header"`
+		Body   string      `json:"body"`
+		Status int         `json:"status"`
+	}
+)
+
+// NewCassette opens the cassette at path. Replay mode loads the file, record mode starts empty and sends requests
+// through next (http.DefaultTransport when nil).
+func NewCassette(path string, mode Mode, next http.RoundTripper) (*Cassette, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	cassette := &Cassette{
+		next:         next,
+		oops:         oops.In(service).Code(errCassette).With("path", path),
+		path:         path,
+		mode:         mode,
+		interactions: nil,
+		replayed:     nil,
+		mutex:        sync.Mutex{},
+	}
+
+	switch mode {
+	case ModeRecord:
+		return cassette, nil
+	case ModeReplay:
+		return cassette, cassette.load()
+	default:
+		return nil, cassette.oops.With("mode", mode).New("unknown cassette mode")
+	}
+}
+
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	if c.mode == ModeReplay {
+		return c.replay(req)
+	}
+
+	return c.record(req)
+}
+
+// Save writes the recorded interactions to the cassette file. It does nothing in replay mode.
+func (c *Cassette) Save() error {
+	if c.mode != ModeRecord {
+		return nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	data := new(bytes.Buffer)
+	encoder := json.NewEncoder(data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(c.interactions)
+	if err != nil {
+		return c.oops.Wrap(err)
+	}
+
+	return c.oops.Wrap(os.WriteFile(c.path, data.Bytes(), filePerm))
+}
+
+func (c *Cassette) load() error {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return c.oops.Wrap(err)
+	}
+
+	err = json.Unmarshal(data, &c.interactions)
+	if err != nil {
+		return c.oops.Wrap(err)
+	}
+
+	c.replayed = make([]bool, len(c.interactions))
+
+	return nil
+}
+
+func (c *Cassette) record(req *http.Request) (*http.Response, error) {
+	sent, err := c.readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.next.RoundTrip(req)
+	if err != nil {
+		return nil, c.oops.Wrap(err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if err != nil {
+		return nil, c.oops.Wrap(err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := req.Header.Clone()
+	if header.Get("Authorization") != "" {
+		header.Set("Authorization", redacted)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.interactions = append(c.interactions, Interaction{
+		Request: RecordedRequest{
+			Header: header,
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  scrub(req.URL.Query()),
+			Body:   string(redact(sent)),
+		},
+		Response: RecordedResponse{
+			Header: resp.Header.Clone(),
+			Body:   string(redact(body)),
+			Status: resp.StatusCode,
+		},
+	})
+
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	sent, err := c.readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	query, body := scrub(req.URL.Query()), string(redact(sent))
+	found := -1
+
+	for idx, interaction := range c.interactions {
+		recorded := interaction.Request
+		if recorded.Method != req.Method || recorded.Path != req.URL.Path || recorded.Query != query ||
+			req.Method != http.MethodGet && recorded.Body != body {
+			continue
+		}
+
+		// prefer interactions not replayed yet so repeated requests walk through their recordings in order
+		if found == -1 || c.replayed[found] && !c.replayed[idx] {
+			found = idx
+		}
+	}
+
+	if found == -1 {
+		return nil, c.oops.
+			With("method", req.Method).
+			With("url", req.URL.Path+"?"+query).
+			New("no recorded interaction matches the request")
+	}
+
+	c.replayed[found] = true
+	recorded := c.interactions[found].Response
+
+	resp := new(http.Response)
+	resp.Status = strconv.Itoa(recorded.Status) + " " + http.StatusText(recorded.Status)
+	resp.StatusCode = recorded.Status
+	resp.Proto, resp.ProtoMajor, resp.ProtoMinor = "HTTP/1.1", 1, 1
+	resp.Header = recorded.Header.Clone()
+	resp.Body = io.NopCloser(bytes.NewBufferString(recorded.Body))
+	resp.ContentLength = int64(len(recorded.Body))
+	resp.Request = req
+
+	return resp, nil
+}
+
+// readBody reads the body of req and puts it back for the transport to send.
+func (c *Cassette) readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, c.oops.Wrap(err)
+}
+
+// redact replaces the values of credential fields, at any depth, in a JSON body. Other bodies are kept as they are.
+func redact(body []byte) []byte {
+	var value any
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	if decoder.Decode(&value) != nil || !mask(value) {
+		return body
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+
+	return data
+}
+
+// mask redacts the credential fields found in a decoded JSON value in place and reports whether it found any.
+func mask(value any) bool {
+	masked := false
+
+	switch node := value.(type) {
+	case map[string]any:
+		for key, child := range node {
+			if credential(key) {
+				node[key] = redacted
+				masked = true
+
+				continue
+			}
+
+			masked = mask(child) || masked
+		}
+	case []any:
+		for _, child := range node {
+			masked = mask(child) || masked
+		}
+	}
+
+	return masked
+}
+
+// credential reports whether a body field holds a credential or identifies whom it was issued to.
+func credential(key string) bool {
+	switch key {
+	case "session_id", "guest_session_id", "access_token", "request_token", "account_id":
+		return true
+	default:
+		return false
+	}
+}
+
+// scrub normalises a query for matching and storage: credentials are replaced and parameters are sorted by Encode.
+func scrub(query url.Values) string {
+	for _, param := range []string{"api_key", "session_id", "guest_session_id"} {
+		if query.Has(param) {
+			query.Set(param, redacted)
+		}
+	}
+
+	return query.Encode()
+}
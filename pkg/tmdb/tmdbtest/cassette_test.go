@@ -0,0 +1,188 @@
+package tmdbtest_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
+)
+
+func TestCassetteRecordReplay(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "popular.json")
+	server := start(t).SeedList(tmdbtest.ListPopular, movies(25)...)
+
+	config := server.Config()
+	config.Auth = tmdb.AuthAPIKey
+
+	recorder := fp.Must(tmdbtest.NewCassette(path, tmdbtest.ModeRecord, nil))
+	live := fp.Must(tmdb.New(config)).SetTransport(recorder)
+
+	first, err := live.GetPopularMovies(t.Context(), 1)
+	require.NoError(t, err)
+
+	second, err := live.GetPopularMovies(t.Context(), 2)
+	require.NoError(t, err)
+
+	require.NoError(t, recorder.Save())
+
+	server.Close()
+
+	data := string(fp.Must(os.ReadFile(path)))
+
+	assert.NotContains(t, data, tmdbtest.Token)
+	assert.Contains(t, data, `"query": "api_key=REDACTED&language=en&page=2"`)
+
+	player := fp.Must(tmdbtest.NewCassette(path, tmdbtest.ModeReplay, nil))
+
+	config.Token = "0123456789abcdef0123456789abcdef"
+	offline := fp.Must(tmdb.New(config)).SetTransport(player)
+
+	got, err := offline.GetPopularMovies(t.Context(), 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, second, got)
+
+	got, err = offline.GetPopularMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, first, got)
+
+	_, err = offline.GetPopularMovies(t.Context(), 3)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no recorded interaction matches the request")
+}
+
+func TestCassetteScrubsAuthorization(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "details.json")
+	server := start(t).Script(tmdbtest.Fault{Path: "", Status: http.StatusNotFound, Delay: 0, Times: 0})
+
+	recorder := fp.Must(tmdbtest.NewCassette(path, tmdbtest.ModeRecord, nil))
+	live := fp.Must(tmdb.New(server.Config())).SetTransport(recorder)
+
+	_, err := live.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{Append: nil})
+	require.Error(t, err)
+	require.NoError(t, recorder.Save())
+
+	data := string(fp.Must(os.ReadFile(path)))
+
+	assert.NotContains(t, data, tmdbtest.Token)
+	assert.Contains(t, data, `"REDACTED"`)
+
+	player := fp.Must(tmdbtest.NewCassette(path, tmdbtest.ModeReplay, nil))
+	offline := fp.Must(tmdb.New(server.Config())).SetTransport(player)
+
+	_, err = offline.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{Append: nil})
+
+	assert.Equal(t, "The resource you requested could not be found.", public(t, err))
+}
+
+func TestCassetteRedactsIssuedCredentials(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "auth.json")
+	server := start(t)
+
+	recorder := fp.Must(tmdbtest.NewCassette(path, tmdbtest.ModeRecord, nil))
+	live := fp.Must(tmdb.New(server.Config())).SetTransport(recorder)
+
+	token := fp.Must(live.CreateRequestToken(t.Context()))
+	server.Approve(token.RequestToken)
+
+	session := fp.Must(live.CreateSession(t.Context(), token.RequestToken))
+	guest := fp.Must(live.CreateGuestSession(t.Context()))
+
+	request := fp.Must(live.CreateAccessRequest(t.Context()))
+	server.Approve(request.RequestToken)
+
+	access := fp.Must(live.CreateAccessToken(t.Context(), request.RequestToken))
+
+	require.NoError(t, recorder.Save())
+
+	data := string(fp.Must(os.ReadFile(path)))
+
+	for _, secret := range []string{
+		token.RequestToken,
+		session.SessionID,
+		guest.GuestSessionID,
+		request.RequestToken,
+		access.AccessToken,
+		access.AccountID,
+	} {
+		assert.NotContains(t, data, secret)
+	}
+
+	assert.Contains(t, data, `\"success\":true`)
+}
+
+func TestCassetteMatchesRequestBodies(t *testing.T) {
+	t.Parallel()
+
+	details := new(tmdb.MovieDetails)
+	details.ID = 550
+
+	path := filepath.Join(t.TempDir(), "rating.json")
+	server := start(t).SeedDetails(*details)
+
+	recorder := fp.Must(tmdbtest.NewCassette(path, tmdbtest.ModeRecord, nil))
+	live := fp.Must(tmdb.New(server.Config())).SetTransport(recorder)
+
+	token := fp.Must(live.CreateRequestToken(t.Context()))
+	server.Approve(token.RequestToken)
+
+	session := fp.Must(live.CreateSession(t.Context(), token.RequestToken))
+
+	config := server.Config()
+	config.SessionID = session.SessionID
+	live = fp.Must(tmdb.New(config)).SetTransport(recorder)
+
+	require.NoError(t, live.RateMovie(t.Context(), 550, 8))
+	require.NoError(t, live.RateMovie(t.Context(), 550, 9.5))
+	require.NoError(t, recorder.Save())
+
+	data := string(fp.Must(os.ReadFile(path)))
+
+	assert.NotContains(t, data, token.RequestToken)
+	assert.Contains(t, data, `"body": "{\"request_token\":\"REDACTED\"}"`)
+	assert.Contains(t, data, `"body": "{\"value\":9.5}\n"`)
+
+	player := fp.Must(tmdbtest.NewCassette(path, tmdbtest.ModeReplay, nil))
+	offline := fp.Must(tmdb.New(server.Config())).SetTransport(player)
+
+	_, err := offline.CreateSession(t.Context(), "another-token")
+	require.NoError(t, err)
+
+	config.SessionID = "another-session"
+	offline = fp.Must(tmdb.New(config)).SetTransport(player)
+
+	require.NoError(t, offline.RateMovie(t.Context(), 550, 9.5))
+	require.NoError(t, offline.RateMovie(t.Context(), 550, 8))
+
+	err = offline.RateMovie(t.Context(), 550, 7)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no recorded interaction matches the request")
+}
+
+func TestNewCassetteFailure(t *testing.T) {
+	t.Parallel()
+
+	_, err := tmdbtest.NewCassette(filepath.Join(t.TempDir(), "missing.json"), tmdbtest.ModeReplay, nil)
+
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = tmdbtest.NewCassette("any.json", tmdbtest.Mode("rewind"), nil)
+
+	require.EqualError(t, err, "unknown cassette mode")
+}
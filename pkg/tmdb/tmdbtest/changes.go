@@ -0,0 +1,122 @@
+package tmdbtest
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	KindMovie  = "movie"
+	KindTV     = "tv"
+	KindPerson = "person"
+
+	// ChangeTime is the layout of tmdb.ChangeItem.Time.
+	ChangeTime = "2006-01-02 15:04:05 MST"
+
+	dateLayout    = "2006-01-02"
+	changesWindow = 14 * 24 * time.Hour
+	day           = 24 * time.Hour
+)
+
+// SeedChanges records changes of the movie, TV show or person (KindMovie, KindTV or KindPerson) with the id. Items
+// are reported on the days of their Time, which must follow ChangeTime.
+func (s *Server) SeedChanges(kind string, id int, changes ...tmdb.Change) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := kind + "/" + strconv.Itoa(id)
+	s.changes[key] = append(s.changes[key], changes...)
+
+	return s
+}
+
+func (s *Server) changedList(kind string) http.HandlerFunc {
+	return func(writer http.ResponseWriter, req *http.Request) {
+		start, end, ok := changesRange(writer, req)
+		if !ok {
+			return
+		}
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		changed := make([]tmdb.ChangedItem, 0)
+
+		for _, key := range slices.Sorted(maps.Keys(s.changes)) {
+			name, raw, _ := strings.Cut(key, "/")
+			id, _ := strconv.Atoi(raw)
+
+			if name == kind && len(within(s.changes[key], start, end)) > 0 {
+				changed = append(changed, tmdb.ChangedItem{ID: id, Adult: false})
+			}
+		}
+
+		paginate(writer, req, changed)
+	}
+}
+
+func (s *Server) itemChanges(kind string) http.HandlerFunc {
+	return func(writer http.ResponseWriter, req *http.Request) {
+		start, end, ok := changesRange(writer, req)
+		if !ok {
+			return
+		}
+
+		s.mutex.Lock()
+		changes := within(s.changes[kind+"/"+req.PathValue("id")], start, end)
+		s.mutex.Unlock()
+
+		write(writer, http.StatusOK, map[string]any{"changes": changes})
+	}
+}
+
+// changesRange reads the requested days, the last 24 hours by default. The end day is included.
+func changesRange(writer http.ResponseWriter, req *http.Request) (time.Time, time.Time, bool) {
+	query := req.URL.Query()
+	end := time.Now().UTC()
+	start := end.Add(-day)
+
+	var errStart, errEnd error
+
+	if raw := query.Get("start_date"); raw != "" {
+		start, errStart = time.Parse(dateLayout, raw)
+	}
+
+	if raw := query.Get("end_date"); raw != "" {
+		end, errEnd = time.Parse(dateLayout, raw)
+		end = end.Add(day)
+	}
+
+	if errStart != nil || errEnd != nil || end.Before(start) || end.Sub(start) > changesWindow+day {
+		reject(writer, http.StatusBadRequest, codeInvalidParams, invalidParams)
+
+		return start, end, false
+	}
+
+	return start, end, true
+}
+
+// within keeps the change items made from start up to end, dropping the changes left empty.
+func within(changes []tmdb.Change, start, end time.Time) []tmdb.Change {
+	kept := make([]tmdb.Change, 0, len(changes))
+
+	for _, change := range changes {
+		items := slices.DeleteFunc(slices.Clone(change.Items), func(item tmdb.ChangeItem) bool {
+			at, err := time.Parse(ChangeTime, item.Time)
+
+			return err != nil || at.Before(start) || !at.Before(end)
+		})
+
+		if len(items) > 0 {
+			kept = append(kept, tmdb.Change{Key: change.Key, Items: items})
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,225 @@
+package tmdbtest
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	maxPages = 500
+
+	codeUnauthorized = 7
+	codeOffline      = 9
+	codeInternal     = 11
+	codeInvalidPage  = 22
+	codeRateLimit    = 25
+	codeNotFound     = 34
+)
+
+type errorBody struct {
+	StatusMessage string `json:"status_message"`
+	StatusCode    int    `json:"status_code"`
+}
+
+func (s *Server) movie(writer http.ResponseWriter, req *http.Request) {
+	name := req.PathValue("name")
+
+	switch List(name) {
+	case ListNowPlaying, ListPopular, ListTopRated, ListUpcoming:
+		s.mutex.Lock()
+		movies := s.lists[List(name)]
+		s.mutex.Unlock()
+
+		paginate(writer, req, movies)
+
+		return
+	}
+
+	if name == "latest" {
+		s.latest(writer)
+
+		return
+	}
+
+	movieID, err := strconv.Atoi(name)
+	if err != nil {
+		failure(writer, http.StatusNotFound)
+
+		return
+	}
+
+	s.mutex.Lock()
+	details, ok := s.details[movieID]
+	s.mutex.Unlock()
+
+	if !ok {
+		failure(writer, http.StatusNotFound)
+
+		return
+	}
+
+	write(writer, http.StatusOK, appended(details, req.URL.Query()))
+}
+
+// latest serves the seeded details with the highest id, the way TMDB serves the last movie added.
+func (s *Server) latest(writer http.ResponseWriter) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.details) == 0 {
+		failure(writer, http.StatusNotFound)
+
+		return
+	}
+
+	write(writer, http.StatusOK, appended(s.details[slices.Max(slices.Collect(maps.Keys(s.details)))], nil))
+}
+
+func (s *Server) search(writer http.ResponseWriter, req *http.Request) {
+	paginate(writer, req, s.find(query(req)))
+}
+
+func (s *Server) find(query string) []tmdb.Movie {
+	var found []tmdb.Movie
+
+	if query == "" {
+		return found
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	seen := make(map[int]struct{})
+	add := func(movie tmdb.Movie) {
+		if _, ok := seen[movie.ID]; ok || !strings.Contains(strings.ToLower(movie.Title), query) {
+			return
+		}
+
+		seen[movie.ID] = struct{}{}
+
+		found = append(found, movie)
+	}
+
+	for _, list := range []List{ListNowPlaying, ListPopular, ListTopRated, ListUpcoming} {
+		for _, movie := range s.lists[list] {
+			add(movie)
+		}
+	}
+
+	for _, movieID := range slices.Sorted(maps.Keys(s.details)) {
+		add(s.details[movieID].Movie)
+	}
+
+	return found
+}
+
+func (s *Server) config(writer http.ResponseWriter, _ *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	write(writer, http.StatusOK, s.configuration)
+}
+
+func paginate[T any](writer http.ResponseWriter, req *http.Request, items []T) {
+	page, ok := pageOf(req, items)
+	if !ok {
+		write(writer, http.StatusBadRequest, errorBody{
+			StatusMessage: "Invalid page: Pages start at 1 and max at 500. They are expected to be an integer.",
+			StatusCode:    codeInvalidPage,
+		})
+
+		return
+	}
+
+	write(writer, http.StatusOK, page)
+}
+
+// pageOf cuts the page requested by the `page` parameter out of items, false when the parameter is invalid.
+func pageOf[T any](req *http.Request, items []T) (tmdb.Page[T], bool) {
+	page := 1
+
+	if raw := req.URL.Query().Get("page"); raw != "" {
+		var err error
+
+		page, err = strconv.Atoi(raw)
+		if err != nil {
+			page = 0
+		}
+	}
+
+	if page < 1 || page > maxPages {
+		return *new(tmdb.Page[T]), false
+	}
+
+	first := min((page-1)*PageSize, len(items))
+	last := min(first+PageSize, len(items))
+	results := items[first:last]
+
+	if results == nil {
+		results = []T{}
+	}
+
+	return tmdb.Page[T]{
+		Results:      results,
+		Page:         page,
+		TotalPages:   min((len(items)+PageSize-1)/PageSize, maxPages),
+		TotalResults: len(items),
+	}, true
+}
+
+func appended(details tmdb.MovieDetails, query url.Values) map[string]any {
+	var body map[string]any
+
+	if details.Images != nil {
+		images := filtered(*details.Images, query)
+		details.Images = &images
+	}
+
+	raw, _ := json.Marshal(details)
+	_ = json.Unmarshal(raw, &body)
+
+	requested := strings.Split(query.Get("append_to_response"), ",")
+
+	for _, name := range tmdb.Appends() {
+		if !slices.Contains(requested, string(name)) {
+			delete(body, string(name))
+		}
+	}
+
+	return body
+}
+
+func failure(writer http.ResponseWriter, status int) {
+	write(writer, status, errorFor(status))
+}
+
+func errorFor(status int) errorBody {
+	body := errorBody{StatusMessage: http.StatusText(status), StatusCode: 0}
+
+	switch status {
+	case http.StatusUnauthorized:
+		body.StatusCode = codeUnauthorized
+		body.StatusMessage = "Invalid API key: You must be granted a valid key."
+	case http.StatusNotFound:
+		body.StatusCode = codeNotFound
+		body.StatusMessage = "The resource you requested could not be found."
+	case http.StatusTooManyRequests:
+		body.StatusCode = codeRateLimit
+		body.StatusMessage = "Your request count (#) is over the allowed limit of (40)."
+	case http.StatusInternalServerError:
+		body.StatusCode = codeInternal
+		body.StatusMessage = "Internal error: Something went wrong, contact TMDB."
+	case http.StatusServiceUnavailable:
+		body.StatusCode = codeOffline
+		body.StatusMessage = "Service offline: This service is temporarily offline, try again later."
+	}
+
+	return body
+}
@@ -0,0 +1,86 @@
+package tmdbtest
+
+import (
+	"cmp"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+// SeedProfiles stores the pictures served by /3/person/{id}/images.
+func (s *Server) SeedProfiles(personID int, profiles ...tmdb.Image) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.profiles[personID] = append(s.profiles[personID], profiles...)
+
+	return s
+}
+
+// images serves the images of the seeded details in the `include_image_language` languages, `null` standing for
+// no language. Without it only the images in the `language` parameter are served, as TMDB does.
+func (s *Server) images(writer http.ResponseWriter, req *http.Request) {
+	details, ok := s.seeded(writer, req)
+	if !ok {
+		return
+	}
+
+	var images tmdb.Images
+
+	if details.Images != nil {
+		images = *details.Images
+	}
+
+	images = filtered(images, req.URL.Query())
+
+	write(writer, http.StatusOK, map[string]any{
+		"id":        details.ID,
+		"backdrops": images.Backdrops,
+		"logos":     images.Logos,
+		"posters":   images.Posters,
+	})
+}
+
+// filtered keeps the images in the languages the query asks for, the way images serves them.
+func filtered(images tmdb.Images, query url.Values) tmdb.Images {
+	languages := strings.Split(query.Get("include_image_language"), ",")
+
+	if query.Get("include_image_language") == "" {
+		language, _, _ := strings.Cut(query.Get("language"), "-")
+		languages = []string{language}
+	}
+
+	keep := func(items []tmdb.Image) []tmdb.Image {
+		kept := make([]tmdb.Image, 0, len(items))
+
+		for _, image := range items {
+			if slices.Contains(languages, cmp.Or(image.Language, "null")) {
+				kept = append(kept, image)
+			}
+		}
+
+		return kept
+	}
+
+	return tmdb.Images{Backdrops: keep(images.Backdrops), Logos: keep(images.Logos), Posters: keep(images.Posters)}
+}
+
+func (s *Server) profileImages(writer http.ResponseWriter, req *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	personID, err := strconv.Atoi(req.PathValue("id"))
+	profiles, ok := s.profiles[personID]
+
+	if err != nil || !ok {
+		failure(writer, http.StatusNotFound)
+
+		return
+	}
+
+	write(writer, http.StatusOK, map[string]any{"id": personID, "profiles": profiles})
+}
@@ -0,0 +1,310 @@
+package tmdbtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+// userList is a v4 list. Every access token of the fake belongs to the same account, which owns every list.
+type userList struct {
+	comments    map[string]string
+	name        string
+	description string
+	language    string
+	items       []tmdb.ListEntry
+	public      bool
+}
+
+// ListItems returns the items of a v4 list with their comments, in the order they were added. It is nil when the
+// list does not exist.
+func (s *Server) ListItems(listID int) []tmdb.ListEntry {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	list, ok := s.userLists[listID]
+	if !ok {
+		return nil
+	}
+
+	items := make([]tmdb.ListEntry, 0, len(list.items))
+
+	for _, item := range list.items {
+		item.Comment = list.comments[entryKey(item)]
+		items = append(items, item)
+	}
+
+	return items
+}
+
+func (s *Server) listCreate(writer http.ResponseWriter, req *http.Request) {
+	var body struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Language    string `json:"iso_639_1"`
+		Public      bool   `json:"public"`
+	}
+
+	if _, ok := s.bearer(req); !ok {
+		unauthenticated(writer)
+
+		return
+	}
+
+	if json.NewDecoder(req.Body).Decode(&body) != nil || body.Name == "" || body.Language == "" {
+		reject(writer, http.StatusBadRequest, codeInvalidParams, invalidParams)
+
+		return
+	}
+
+	s.mutex.Lock()
+	s.serial++
+	listID := s.serial
+	s.userLists[listID] = &userList{
+		comments:    make(map[string]string),
+		name:        body.Name,
+		description: body.Description,
+		language:    body.Language,
+		items:       nil,
+		public:      body.Public,
+	}
+	s.mutex.Unlock()
+
+	write(writer, http.StatusCreated, map[string]any{
+		"success":        true,
+		"status_code":    codeSuccess,
+		"status_message": "The item/record was created successfully.",
+		"id":             listID,
+	})
+}
+
+func (s *Server) listGet(writer http.ResponseWriter, req *http.Request) {
+	_, user := s.bearer(req)
+	listID, err := strconv.Atoi(req.PathValue("id"))
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	list, ok := s.userLists[listID]
+
+	switch {
+	case err != nil || !ok:
+		failure(writer, http.StatusNotFound)
+
+		return
+	case !list.public && !user:
+		unauthenticated(writer)
+
+		return
+	}
+
+	movies := s.movies()
+	items := make([]tmdb.ListItem, 0, len(list.items))
+
+	for _, entry := range list.items {
+		items = append(items, s.listItem(movies, entry))
+	}
+
+	page, valid := pageOf(req, items)
+	if !valid {
+		reject(writer, http.StatusBadRequest, codeInvalidPage,
+			"Invalid page: Pages start at 1 and max at 500. They are expected to be an integer.")
+
+		return
+	}
+
+	write(writer, http.StatusOK, tmdb.List{
+		Comments:     list.comments,
+		Name:         list.name,
+		Description:  list.description,
+		Language:     list.language,
+		Results:      page.Results,
+		ID:           listID,
+		Page:         page.Page,
+		TotalPages:   page.TotalPages,
+		TotalResults: page.TotalResults,
+		Public:       list.public,
+	})
+}
+
+func (s *Server) listUpdate(writer http.ResponseWriter, req *http.Request) {
+	var body struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Public      bool   `json:"public"`
+	}
+
+	list, ok := s.owned(writer, req)
+	if !ok {
+		return
+	}
+
+	if json.NewDecoder(req.Body).Decode(&body) != nil || body.Name == "" {
+		reject(writer, http.StatusBadRequest, codeInvalidParams, invalidParams)
+
+		return
+	}
+
+	s.mutex.Lock()
+	list.name = body.Name
+	list.description = body.Description
+	list.public = body.Public
+	s.mutex.Unlock()
+
+	acknowledge(writer, http.StatusCreated, codeUpdated, "The item/record was updated successfully.")
+}
+
+func (s *Server) listDelete(writer http.ResponseWriter, req *http.Request) {
+	if _, ok := s.owned(writer, req); !ok {
+		return
+	}
+
+	listID, _ := strconv.Atoi(req.PathValue("id"))
+
+	s.mutex.Lock()
+	delete(s.userLists, listID)
+	s.mutex.Unlock()
+
+	acknowledge(writer, http.StatusOK, codeDeleted, "The item/record was deleted successfully.")
+}
+
+func (s *Server) listClear(writer http.ResponseWriter, req *http.Request) {
+	list, ok := s.owned(writer, req)
+	if !ok {
+		return
+	}
+
+	s.mutex.Lock()
+	list.items = nil
+	list.comments = make(map[string]string)
+	s.mutex.Unlock()
+
+	acknowledge(writer, http.StatusOK, codeSuccess, "Success.")
+}
+
+// listItems adds, updates or removes items depending on the method. Like TMDB it answers 200 and reports every
+// item that could not be changed in the results.
+func (s *Server) listItems(writer http.ResponseWriter, req *http.Request) {
+	var body struct {
+		Items []tmdb.ListEntry `json:"items"`
+	}
+
+	list, ok := s.owned(writer, req)
+	if !ok {
+		return
+	}
+
+	if json.NewDecoder(req.Body).Decode(&body) != nil || len(body.Items) == 0 {
+		reject(writer, http.StatusBadRequest, codeInvalidParams, invalidParams)
+
+		return
+	}
+
+	known := make([]bool, len(body.Items))
+	for idx, item := range body.Items {
+		known[idx] = s.known(item.MediaType, item.MediaID)
+	}
+
+	results := make([]map[string]any, 0, len(body.Items))
+
+	s.mutex.Lock()
+
+	for idx, item := range body.Items {
+		results = append(results, map[string]any{
+			"media_type": item.MediaType,
+			"media_id":   item.MediaID,
+			"success":    known[idx] && list.change(req.Method, item),
+		})
+	}
+
+	s.mutex.Unlock()
+
+	write(writer, http.StatusOK, map[string]any{"success": true, "status_code": codeSuccess, "results": results})
+}
+
+// owned returns the list of the request path. It writes the rejection and returns false when there is no such list
+// or no access token. The caller does not hold the mutex.
+func (s *Server) owned(writer http.ResponseWriter, req *http.Request) (*userList, bool) {
+	if _, ok := s.bearer(req); !ok {
+		unauthenticated(writer)
+
+		return nil, false
+	}
+
+	listID, err := strconv.Atoi(req.PathValue("id"))
+
+	s.mutex.Lock()
+	list, ok := s.userLists[listID]
+	s.mutex.Unlock()
+
+	if err != nil || !ok {
+		failure(writer, http.StatusNotFound)
+
+		return nil, false
+	}
+
+	return list, true
+}
+
+// listItem resolves a list entry to the movie or TV show it points at. The caller holds the mutex.
+func (s *Server) listItem(movies map[int]tmdb.Movie, entry tmdb.ListEntry) tmdb.ListItem {
+	item := tmdb.ListItem{
+		MediaType:    entry.MediaType,
+		Title:        "",
+		Name:         "",
+		Overview:     "",
+		ReleaseDate:  "",
+		FirstAirDate: "",
+		Popularity:   0,
+		ID:           entry.MediaID,
+		VoteCount:    0,
+	}
+
+	if entry.MediaType == tmdb.MediaTV {
+		show := s.tv[entry.MediaID]
+		item.Name, item.Overview, item.FirstAirDate = show.Name, show.Overview, show.FirstAirDate
+		item.Popularity, item.VoteCount = show.Popularity, show.VoteCount
+
+		return item
+	}
+
+	movie := movies[entry.MediaID]
+	item.Title, item.Overview, item.ReleaseDate = movie.Title, movie.Overview, movie.ReleaseDate
+	item.Popularity, item.VoteCount = movie.Popularity, movie.VoteCount
+
+	return item
+}
+
+// change applies one item of an items call and reports whether it succeeded. The caller holds the mutex.
+func (l *userList) change(method string, item tmdb.ListEntry) bool {
+	key := entryKey(item)
+	index := slices.IndexFunc(l.items, func(entry tmdb.ListEntry) bool { return entryKey(entry) == key })
+
+	switch {
+	case method == http.MethodPost && index == -1:
+		l.items = append(l.items, tmdb.ListEntry{MediaType: item.MediaType, Comment: "", MediaID: item.MediaID})
+	case method == http.MethodPut && index != -1:
+	case method == http.MethodDelete && index != -1:
+		l.items = slices.Delete(l.items, index, index+1)
+		delete(l.comments, key)
+
+		return true
+	default:
+		return false
+	}
+
+	if item.Comment == "" {
+		delete(l.comments, key)
+	} else {
+		l.comments[key] = item.Comment
+	}
+
+	return true
+}
+
+func entryKey(entry tmdb.ListEntry) string {
+	return string(entry.MediaType) + ":" + strconv.Itoa(entry.MediaID)
+}
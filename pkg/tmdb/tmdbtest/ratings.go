@@ -0,0 +1,116 @@
+package tmdbtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+const (
+	ratingMax = 10
+
+	codeSuccess       = 1
+	codeAuthFailed    = 3
+	codeUpdated       = 12
+	codeDeleted       = 13
+	codeValueTooLow   = 18
+	codeValueTooHigh  = 19
+	codeInvalidRating = 20
+)
+
+// Rating returns the value rated for movieID. The fake keeps a single rating per movie, whatever the session.
+func (s *Server) Rating(movieID int) (float64, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	value, ok := s.ratings[movieID]
+
+	return value, ok
+}
+
+func (s *Server) rate(writer http.ResponseWriter, req *http.Request) {
+	var body struct {
+		Value float64 `json:"value"`
+	}
+
+	movieID, ok := s.rated(writer, req)
+	if !ok {
+		return
+	}
+
+	err := json.NewDecoder(req.Body).Decode(&body)
+
+	switch {
+	case err != nil:
+		reject(writer, http.StatusBadRequest, codeInvalidRating, "Value invalid: Values must be a multiple of 0.50.")
+
+		return
+	case body.Value <= 0:
+		reject(writer, http.StatusBadRequest, codeValueTooLow, "Value too low: Value must be greater than 0.")
+
+		return
+	case body.Value > ratingMax:
+		reject(writer, http.StatusBadRequest, codeValueTooHigh,
+			"Value too high: Value must be less than, or equal to 10.0.")
+
+		return
+	}
+
+	s.mutex.Lock()
+	_, updated := s.ratings[movieID]
+	s.ratings[movieID] = body.Value
+	s.mutex.Unlock()
+
+	if updated {
+		acknowledge(writer, http.StatusCreated, codeUpdated, "The item/record was updated successfully.")
+	} else {
+		acknowledge(writer, http.StatusCreated, codeSuccess, "Success.")
+	}
+}
+
+func (s *Server) unrate(writer http.ResponseWriter, req *http.Request) {
+	movieID, ok := s.rated(writer, req)
+	if !ok {
+		return
+	}
+
+	s.mutex.Lock()
+	_, found := s.ratings[movieID]
+	delete(s.ratings, movieID)
+	s.mutex.Unlock()
+
+	if !found {
+		failure(writer, http.StatusNotFound)
+
+		return
+	}
+
+	acknowledge(writer, http.StatusOK, codeDeleted, "The item/record was deleted successfully.")
+}
+
+// rated checks the session and the movie of a rating request, answering with an error when either is unknown.
+func (s *Server) rated(writer http.ResponseWriter, req *http.Request) (int, bool) {
+	query := req.URL.Query()
+	movieID, err := strconv.Atoi(req.PathValue("id"))
+
+	s.mutex.Lock()
+	_, user := s.sessions[query.Get("session_id")]
+	_, guest := s.guests[query.Get("guest_session_id")]
+	_, known := s.details[movieID]
+	s.mutex.Unlock()
+
+	switch {
+	case !user && !guest:
+		unauthenticated(writer)
+	case err != nil || !known:
+		failure(writer, http.StatusNotFound)
+	default:
+		return movieID, true
+	}
+
+	return 0, false
+}
+
+func acknowledge(writer http.ResponseWriter, status, code int, message string) {
+	write(writer, status, map[string]any{"success": true, "status_code": code, "status_message": message})
+}
@@ -0,0 +1,43 @@
+package tmdbtest
+
+import (
+	"net/http"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+// reference serves a fixed sample of the reference data under /3/configuration/.
+func reference(data any) http.HandlerFunc {
+	return func(writer http.ResponseWriter, _ *http.Request) {
+		write(writer, http.StatusOK, data)
+	}
+}
+
+func countries() []tmdb.Country {
+	return []tmdb.Country{
+		{Code: "DE", EnglishName: "Germany", NativeName: "Deutschland"},
+		{Code: "FR", EnglishName: "France", NativeName: "France"},
+		{Code: "GB", EnglishName: "United Kingdom", NativeName: "United Kingdom"},
+		{Code: "US", EnglishName: "United States of America", NativeName: "United States"},
+	}
+}
+
+func languages() []tmdb.Language {
+	return []tmdb.Language{
+		{Code: "de", EnglishName: "German", Name: "Deutsch"},
+		{Code: "en", EnglishName: "English", Name: "English"},
+		{Code: "es", EnglishName: "Spanish", Name: "Español"},
+		{Code: "fr", EnglishName: "French", Name: "Français"},
+	}
+}
+
+func primaryTranslations() []string {
+	return []string{"de-DE", "en-GB", "en-US", "es-ES", "es-MX", "fr-FR"}
+}
+
+func timezones() []tmdb.Timezones {
+	return []tmdb.Timezones{
+		{Country: "DE", Zones: []string{"Europe/Berlin", "Europe/Busingen"}},
+		{Country: "US", Zones: []string{"America/New_York", "America/Chicago", "America/Los_Angeles"}},
+	}
+}
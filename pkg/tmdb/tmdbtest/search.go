@@ -0,0 +1,92 @@
+package tmdbtest
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+// SeedPeople stores the people found by /3/search/person and /3/search/multi.
+func (s *Server) SeedPeople(people ...tmdb.Person) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, person := range people {
+		s.people[person.ID] = person
+	}
+
+	return s
+}
+
+func (s *Server) searchTV(writer http.ResponseWriter, req *http.Request) {
+	paginate(writer, req, s.findShows(query(req)))
+}
+
+func (s *Server) searchPerson(writer http.ResponseWriter, req *http.Request) {
+	paginate(writer, req, s.findPeople(query(req)))
+}
+
+// searchMulti serves the movies, then the shows, then the people matching the query, each with its `media_type`.
+func (s *Server) searchMulti(writer http.ResponseWriter, req *http.Request) {
+	found := make([]tmdb.SearchResult, 0)
+	search := query(req)
+
+	for _, movie := range s.find(search) {
+		found = append(found, tmdb.SearchResult{Movie: &movie, TVShow: nil, Person: nil, MediaType: tmdb.MediaMovie})
+	}
+
+	for _, show := range s.findShows(search) {
+		found = append(found, tmdb.SearchResult{Movie: nil, TVShow: &show, Person: nil, MediaType: tmdb.MediaTV})
+	}
+
+	for _, person := range s.findPeople(search) {
+		found = append(found, tmdb.SearchResult{Movie: nil, TVShow: nil, Person: &person, MediaType: tmdb.MediaPerson})
+	}
+
+	paginate(writer, req, found)
+}
+
+// findShows matches the shows seeded by SeedTV and by SeedShow.
+func (s *Server) findShows(search string) []tmdb.TVShow {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	shows := maps.Clone(s.tv)
+
+	for seriesID, details := range s.shows {
+		shows[seriesID] = details.TVShow
+	}
+
+	return matching(shows, search, func(show tmdb.TVShow) string { return show.Name })
+}
+
+func (s *Server) findPeople(search string) []tmdb.Person {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return matching(s.people, search, func(person tmdb.Person) string { return person.Name })
+}
+
+// matching returns the items whose name contains search, ordered by id.
+func matching[T any](items map[int]T, search string, name func(T) string) []T {
+	found := make([]T, 0)
+
+	if search == "" {
+		return found
+	}
+
+	for _, itemID := range slices.Sorted(maps.Keys(items)) {
+		if strings.Contains(strings.ToLower(name(items[itemID])), search) {
+			found = append(found, items[itemID])
+		}
+	}
+
+	return found
+}
+
+func query(req *http.Request) string {
+	return strings.ToLower(strings.TrimSpace(req.URL.Query().Get("query")))
+}
@@ -0,0 +1,321 @@
+// Package tmdbtest provides an in-memory fake of the TMDB API for tests built on tmdb.Client.
+package tmdbtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+const (
+	// Token is the only credential the fake accepts, either as a bearer token or as an `api_key` parameter.
+	Token = "tmdbtest-token"
+
+	// PageSize is the number of results per page, the same as TMDB uses.
+	PageSize = 20
+
+	ListNowPlaying List = "now_playing"
+	ListPopular    List = "popular"
+	ListTopRated   List = "top_rated"
+	ListUpcoming   List = "upcoming"
+)
+
+type (
+	// List names one of the movie list endpoints served under /3/movie/.
+	List string
+
+	// Fault scripts a response for requests whose path starts with Path (every request when empty). Status replaces
+	// the normal response with a TMDB error body, Delay holds the response back. Times limits how many requests are
+	// affected, zero means all of them.
+	Fault struct {
+		Path   string
+		Status int
+		Delay  time.Duration
+		Times  int
+	}
+
+	// Server is an httptest.Server speaking the TMDB API from seeded fixtures. It is safe for concurrent use.
+	Server struct {
+		*httptest.Server
+
+		lists         map[List][]tmdb.Movie
+		details       map[int]tmdb.MovieDetails
+		tv            map[int]tmdb.TVShow
+		shows         map[int]tmdb.TVShowDetails
+		seasons       map[int][]tmdb.Season
+		credits       map[int]tmdb.AggregateCredits
+		people        map[int]tmdb.Person
+		profiles      map[int][]tmdb.Image
+		collections   map[string][]int
+		configuration map[string]any
+		hits          map[string]int
+		tokens        map[string]bool
+		sessions      map[string]struct{}
+		guests        map[string]struct{}
+		access        map[string]struct{}
+		ratings       map[int]float64
+		changes       map[string][]tmdb.Change
+		userLists     map[int]*userList
+		faults        []*Fault
+		serial        int
+		mutex         sync.Mutex
+	}
+)
+
+// NewServer starts a fake with empty lists and a default configuration. Close it when done.
+func NewServer() *Server {
+	server := &Server{
+		Server:        nil,
+		lists:         make(map[List][]tmdb.Movie),
+		details:       make(map[int]tmdb.MovieDetails),
+		tv:            make(map[int]tmdb.TVShow),
+		shows:         make(map[int]tmdb.TVShowDetails),
+		seasons:       make(map[int][]tmdb.Season),
+		credits:       make(map[int]tmdb.AggregateCredits),
+		people:        make(map[int]tmdb.Person),
+		profiles:      make(map[int][]tmdb.Image),
+		collections:   make(map[string][]int),
+		configuration: configuration(),
+		hits:          make(map[string]int),
+		tokens:        make(map[string]bool),
+		sessions:      make(map[string]struct{}),
+		guests:        make(map[string]struct{}),
+		access:        make(map[string]struct{}),
+		ratings:       make(map[int]float64),
+		changes:       make(map[string][]tmdb.Change),
+		userLists:     make(map[int]*userList),
+		faults:        nil,
+		serial:        0,
+		mutex:         sync.Mutex{},
+	}
+
+	mux := http.NewServeMux()
+	server.routeMovies(mux)
+	server.routeTV(mux)
+	server.routeAccount(mux)
+	server.routeV4(mux)
+
+	server.Server = httptest.NewServer(server.middleware(mux))
+
+	return server
+}
+
+// routeTV serves the shows with their seasons and episodes.
+func (s *Server) routeTV(mux *http.ServeMux) {
+	mux.HandleFunc("GET /3/tv/{id}", s.show)
+	mux.HandleFunc("GET /3/tv/{id}/aggregate_credits", s.aggregateCredits)
+	mux.HandleFunc("GET /3/tv/{id}/season/{season}", s.season)
+	mux.HandleFunc("GET /3/tv/{id}/season/{season}/episode/{episode}", s.episode)
+}
+
+// routeMovies serves the read-only catalogue: movies, reference data and changes.
+func (s *Server) routeMovies(mux *http.ServeMux) {
+	mux.HandleFunc("GET /3/movie/{name}", s.movie)
+	mux.HandleFunc("GET /3/search/movie", s.search)
+	mux.HandleFunc("GET /3/search/tv", s.searchTV)
+	mux.HandleFunc("GET /3/search/person", s.searchPerson)
+	mux.HandleFunc("GET /3/search/multi", s.searchMulti)
+	mux.HandleFunc("GET /3/configuration", s.config)
+	mux.HandleFunc("GET /3/configuration/countries", reference(countries()))
+	mux.HandleFunc("GET /3/configuration/languages", reference(languages()))
+	mux.HandleFunc("GET /3/configuration/primary_translations", reference(primaryTranslations()))
+	mux.HandleFunc("GET /3/configuration/timezones", reference(timezones()))
+	mux.HandleFunc("GET /3/movie/{id}/alternative_titles", s.altTitles)
+	mux.HandleFunc("GET /3/movie/{id}/translations", s.translations)
+	mux.HandleFunc("GET /3/movie/{id}/images", s.images)
+	mux.HandleFunc("GET /3/person/{id}/images", s.profileImages)
+	mux.HandleFunc("GET /3/movie/changes", s.changedList(KindMovie))
+	mux.HandleFunc("GET /3/tv/changes", s.changedList(KindTV))
+	mux.HandleFunc("GET /3/person/changes", s.changedList(KindPerson))
+	mux.HandleFunc("GET /3/movie/{id}/changes", s.itemChanges(KindMovie))
+	mux.HandleFunc("GET /3/tv/{id}/changes", s.itemChanges(KindTV))
+	mux.HandleFunc("GET /3/person/{id}/changes", s.itemChanges(KindPerson))
+}
+
+// routeAccount serves the v3 authentication and everything acting on behalf of a user.
+func (s *Server) routeAccount(mux *http.ServeMux) {
+	mux.HandleFunc("GET /3/authentication/token/new", s.requestToken)
+	mux.HandleFunc("POST /3/authentication/session/new", s.sessionNew)
+	mux.HandleFunc("POST /3/authentication/session/convert/4", s.sessionConvert)
+	mux.HandleFunc("GET /3/authentication/guest_session/new", s.guestSession)
+	mux.HandleFunc("DELETE /3/authentication/session", s.sessionDelete)
+	mux.HandleFunc("POST /3/movie/{id}/rating", s.rate)
+	mux.HandleFunc("DELETE /3/movie/{id}/rating", s.unrate)
+	mux.HandleFunc("GET /3/movie/{id}/account_states", s.accountStates)
+	mux.HandleFunc("GET /3/account", s.account)
+	mux.HandleFunc("POST /3/account/{account}/{collection}", s.mark)
+	mux.HandleFunc("GET /3/account/{account}/{collection}/{media}", s.collection)
+}
+
+// routeV4 serves the v4 authentication and the user lists.
+func (s *Server) routeV4(mux *http.ServeMux) {
+	mux.HandleFunc("POST /4/auth/request_token", s.accessRequest)
+	mux.HandleFunc("POST /4/auth/access_token", s.accessToken)
+	mux.HandleFunc("DELETE /4/auth/access_token", s.accessDelete)
+	mux.HandleFunc("POST /4/list", s.listCreate)
+	mux.HandleFunc("GET /4/list/{id}", s.listGet)
+	mux.HandleFunc("PUT /4/list/{id}", s.listUpdate)
+	mux.HandleFunc("DELETE /4/list/{id}", s.listDelete)
+	mux.HandleFunc("GET /4/list/{id}/clear", s.listClear)
+	mux.HandleFunc("POST /4/list/{id}/items", s.listItems)
+	mux.HandleFunc("PUT /4/list/{id}/items", s.listItems)
+	mux.HandleFunc("DELETE /4/list/{id}/items", s.listItems)
+}
+
+// Config returns a client configuration pointing at the fake.
+func (s *Server) Config() tmdb.Config {
+	return tmdb.Config{
+		Logger:         nil,
+		Tracing:        nil,
+		Metrics:        nil,
+		Breaker:        nil,
+		Host:           s.URL,
+		Token:          Token,
+		Auth:           tmdb.AuthBearer,
+		SessionID:      "",
+		GuestSessionID: "",
+		AccessToken:    "",
+		Language:       "",
+		Region:         "",
+		Timeout:        time.Minute,
+	}
+}
+
+// SeedList appends movies to a list. Their order is the order the list pages them in.
+func (s *Server) SeedList(list List, movies ...tmdb.Movie) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.lists[list] = append(s.lists[list], movies...)
+
+	return s
+}
+
+// SeedDetails stores details served by /3/movie/{id}. Optional sub-resources are only returned when requested via
+// `append_to_response`.
+func (s *Server) SeedDetails(details ...tmdb.MovieDetails) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, detail := range details {
+		s.details[detail.ID] = detail
+	}
+
+	return s
+}
+
+// SeedConfiguration replaces the body served by /3/configuration.
+func (s *Server) SeedConfiguration(configuration map[string]any) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.configuration = configuration
+
+	return s
+}
+
+// Script queues faults. The first fault matching a request is applied.
+func (s *Server) Script(faults ...Fault) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, fault := range faults {
+		s.faults = append(s.faults, &fault)
+	}
+
+	return s
+}
+
+// Hits returns how many requests reached path, faults and rejected credentials included.
+func (s *Server) Hits(path string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.hits[path]
+}
+
+func (s *Server) middleware(next http.Handler) http.HandlerFunc {
+	return func(writer http.ResponseWriter, req *http.Request) {
+		fault := s.hit(req.URL.Path)
+
+		if fault.Delay > 0 {
+			select {
+			case <-time.After(fault.Delay):
+			case <-req.Context().Done():
+				return
+			}
+		}
+
+		switch {
+		case fault.Status != 0:
+			failure(writer, fault.Status)
+		case !s.authorized(req):
+			failure(writer, http.StatusUnauthorized)
+		default:
+			next.ServeHTTP(writer, req)
+		}
+	}
+}
+
+func (s *Server) hit(path string) Fault {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.hits[path]++
+
+	for idx, fault := range s.faults {
+		if !strings.HasPrefix(path, fault.Path) {
+			continue
+		}
+
+		if fault.Times > 0 {
+			fault.Times--
+
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:idx], s.faults[idx+1:]...)
+			}
+		}
+
+		return *fault
+	}
+
+	return Fault{Path: "", Status: 0, Delay: 0, Times: 0}
+}
+
+// authorized accepts Token and the v4 user access tokens the fake issued.
+func (s *Server) authorized(req *http.Request) bool {
+	if req.Header.Get("Authorization") == "Bearer "+Token || req.URL.Query().Get("api_key") == Token {
+		return true
+	}
+
+	_, ok := s.bearer(req)
+
+	return ok
+}
+
+func write(writer http.ResponseWriter, status int, body any) {
+	writer.Header().Set("Content-Type", "application/json;charset=utf-8")
+	writer.WriteHeader(status)
+
+	_ = json.NewEncoder(writer).Encode(body)
+}
+
+func configuration() map[string]any {
+	return map[string]any{
+		"images": map[string]any{
+			"base_url":        "http://image.tmdb.org/t/p/",
+			"secure_base_url": "https://image.tmdb.org/t/p/",
+			"backdrop_sizes":  []string{"w300", "w780", "w1280", "original"},
+			"logo_sizes":      []string{"w45", "w92", "w154", "w185", "w300", "w500", "original"},
+			"poster_sizes":    []string{"w92", "w154", "w185", "w342", "w500", "w780", "original"},
+			"profile_sizes":   []string{"w45", "w185", "h632", "original"},
+			"still_sizes":     []string{"w92", "w185", "w300", "original"},
+		},
+		"change_keys": []string{},
+	}
+}
@@ -0,0 +1,244 @@
+package tmdbtest_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/tmdbtest"
+)
+
+func movies(count int) []tmdb.Movie {
+	result := make([]tmdb.Movie, 0, count)
+
+	for idx := 1; idx <= count; idx++ {
+		result = append(result, tmdb.Movie{
+			Title:       "Movie " + strconv.Itoa(idx),
+			Overview:    "",
+			ReleaseDate: "",
+			Popularity:  0,
+			ID:          idx,
+			VoteCount:   0,
+		})
+	}
+
+	return result
+}
+
+func client(t *testing.T, server *tmdbtest.Server) *tmdb.TMDB {
+	t.Helper()
+
+	obj := fp.Must(tmdb.New(server.Config()))
+
+	t.Cleanup(func() { _ = obj.Close() })
+
+	return obj
+}
+
+func start(t *testing.T) *tmdbtest.Server {
+	t.Helper()
+
+	server := tmdbtest.NewServer()
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func public(t *testing.T, err error) string {
+	t.Helper()
+
+	var orr oops.OopsError
+
+	require.ErrorAs(t, err, &orr)
+
+	return orr.Public()
+}
+
+func TestServerPagination(t *testing.T) {
+	t.Parallel()
+
+	server := start(t).SeedList(tmdbtest.ListPopular, movies(45)...)
+	obj := client(t, server)
+
+	got, err := obj.GetPopularMovies(t.Context(), 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, movies(45)[40:], got)
+
+	var walked []tmdb.Movie
+
+	for movie, err := range obj.AllPopularMovies(t.Context(), tmdb.PageOptions{Start: 0, Limit: 0}) {
+		require.NoError(t, err)
+
+		walked = append(walked, movie)
+	}
+
+	assert.Equal(t, movies(45), walked)
+	assert.Equal(t, 4, server.Hits("/3/movie/popular"))
+
+	empty, err := obj.GetTopRatedMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	_, err = obj.GetPopularMovies(t.Context(), 501)
+
+	assert.Equal(
+		t,
+		"Invalid page: Pages start at 1 and max at 500. They are expected to be an integer.",
+		public(t, err),
+	)
+}
+
+func TestServerDetails(t *testing.T) {
+	t.Parallel()
+
+	details := new(tmdb.MovieDetails)
+	details.ID = 550
+	details.Title = "Fight Club"
+	details.Credits = &tmdb.Credits{Cast: nil, Crew: []tmdb.Crew{
+		{Name: "David Fincher", Job: "Director", Department: "Directing", ID: 7467},
+	}}
+	details.ExternalIDs = new(tmdb.ExternalIDs)
+	details.ExternalIDs.IMDbID = "tt0137523"
+
+	obj := client(t, start(t).SeedDetails(*details))
+
+	full, err := obj.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{Append: tmdb.Appends()})
+
+	require.NoError(t, err)
+	assert.Equal(t, *details, full)
+
+	bare, err := obj.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{Append: []tmdb.Append{tmdb.AppendCredits}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Fight Club", bare.Title)
+	assert.NotNil(t, bare.Credits)
+	assert.Nil(t, bare.ExternalIDs)
+
+	_, err = obj.GetMovieDetails(t.Context(), 13, tmdb.DetailsOptions{Append: nil})
+
+	assert.Equal(t, "The resource you requested could not be found.", public(t, err))
+}
+
+func TestServerUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	server := start(t)
+	config := server.Config()
+	config.Token = "wrong"
+
+	obj := fp.Must(tmdb.New(config))
+	_, err := obj.GetPopularMovies(t.Context(), 1)
+
+	assert.Equal(t, "Invalid API key: You must be granted a valid key.", public(t, err))
+
+	config.Token = tmdbtest.Token
+	config.Auth = tmdb.AuthAPIKey
+
+	obj = fp.Must(tmdb.New(config))
+	_, err = obj.GetPopularMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+}
+
+func TestServerScript(t *testing.T) {
+	t.Parallel()
+
+	server := start(t).SeedList(tmdbtest.ListUpcoming, movies(1)...).Script(
+		tmdbtest.Fault{Path: "/3/movie/upcoming", Status: http.StatusTooManyRequests, Delay: 0, Times: 1},
+		tmdbtest.Fault{Path: "/3/movie/popular", Status: http.StatusServiceUnavailable, Delay: 0, Times: 0},
+	)
+	obj := client(t, server)
+
+	_, err := obj.GetUpcomingMovies(t.Context(), 1)
+
+	assert.Equal(t, "Your request count (#) is over the allowed limit of (40).", public(t, err))
+
+	got, err := obj.GetUpcomingMovies(t.Context(), 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, movies(1), got)
+
+	for range 2 {
+		_, err = obj.GetPopularMovies(t.Context(), 1)
+
+		assert.Equal(t, "Service offline: This service is temporarily offline, try again later.", public(t, err))
+	}
+
+	assert.Equal(t, 2, server.Hits("/3/movie/upcoming"))
+	assert.Equal(t, 2, server.Hits("/3/movie/popular"))
+}
+
+func TestServerDelay(t *testing.T) {
+	t.Parallel()
+
+	server := start(t).Script(tmdbtest.Fault{Path: "", Status: 0, Delay: time.Minute, Times: 1})
+	obj := client(t, server)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := obj.GetNowPlayingMovies(ctx, 1)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestServerSearchAndConfiguration(t *testing.T) {
+	t.Parallel()
+
+	server := start(t).
+		SeedList(tmdbtest.ListPopular, movies(12)...).
+		SeedList(tmdbtest.ListTopRated, movies(3)...)
+
+	var page tmdb.MoviesPage
+
+	get(t, server, "/3/search/movie?query=movie+1", &page)
+
+	assert.Equal(t, []int{1, 10, 11, 12}, ids(page.Results))
+	assert.Equal(t, 4, page.TotalResults)
+	assert.Equal(t, 1, page.TotalPages)
+
+	var configuration map[string]any
+
+	get(t, server, "/3/configuration", &configuration)
+
+	assert.Contains(t, configuration, "images")
+}
+
+func get(t *testing.T, server *tmdbtest.Server, path string, result any) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+path, nil)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer "+tmdbtest.Token)
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+
+	defer func() { _ = resp.Body.Close() }()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(result))
+}
+
+func ids(movies []tmdb.Movie) []int {
+	result := make([]int, 0, len(movies))
+
+	for _, movie := range movies {
+		result = append(result, movie.ID)
+	}
+
+	return result
+}
@@ -0,0 +1,61 @@
+package tmdbtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+// altTitles serves the alternative titles of the seeded details, narrowed by the `country` parameter.
+func (s *Server) altTitles(writer http.ResponseWriter, req *http.Request) {
+	details, ok := s.seeded(writer, req)
+	if !ok {
+		return
+	}
+
+	titles := make([]tmdb.AltTitle, 0)
+	country := req.URL.Query().Get("country")
+
+	if details.AltTitles != nil {
+		for _, title := range details.AltTitles.Titles {
+			if country == "" || title.Country == country {
+				titles = append(titles, title)
+			}
+		}
+	}
+
+	write(writer, http.StatusOK, map[string]any{"id": details.ID, "titles": titles})
+}
+
+func (s *Server) translations(writer http.ResponseWriter, req *http.Request) {
+	details, ok := s.seeded(writer, req)
+	if !ok {
+		return
+	}
+
+	translations := make([]tmdb.Translation, 0)
+
+	if details.Translations != nil {
+		translations = details.Translations.Translations
+	}
+
+	write(writer, http.StatusOK, map[string]any{"id": details.ID, "translations": translations})
+}
+
+// seeded returns the details of the movie in the path, answering 404 when there are none.
+func (s *Server) seeded(writer http.ResponseWriter, req *http.Request) (tmdb.MovieDetails, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	movieID, err := strconv.Atoi(req.PathValue("id"))
+	details, ok := s.details[movieID]
+
+	if err != nil || !ok {
+		failure(writer, http.StatusNotFound)
+
+		return details, false
+	}
+
+	return details, true
+}
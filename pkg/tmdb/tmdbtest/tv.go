@@ -0,0 +1,111 @@
+package tmdbtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+// SeedShow stores details served by /3/tv/{id} and the seasons served by /3/tv/{id}/season/{n}, along with their
+// episodes. The season summaries of the details are left as given.
+func (s *Server) SeedShow(details tmdb.TVShowDetails, seasons ...tmdb.Season) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.shows[details.ID] = details
+	s.seasons[details.ID] = append(s.seasons[details.ID], seasons...)
+
+	return s
+}
+
+// SeedAggregateCredits stores the credits served by /3/tv/{id}/aggregate_credits.
+func (s *Server) SeedAggregateCredits(seriesID int, credits tmdb.AggregateCredits) *Server {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.credits[seriesID] = credits
+
+	return s
+}
+
+func (s *Server) show(writer http.ResponseWriter, req *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	seriesID, err := strconv.Atoi(req.PathValue("id"))
+	details, ok := s.shows[seriesID]
+
+	if err != nil || !ok {
+		failure(writer, http.StatusNotFound)
+
+		return
+	}
+
+	write(writer, http.StatusOK, details)
+}
+
+func (s *Server) aggregateCredits(writer http.ResponseWriter, req *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	seriesID, err := strconv.Atoi(req.PathValue("id"))
+	credits, ok := s.credits[seriesID]
+
+	if err != nil || !ok {
+		failure(writer, http.StatusNotFound)
+
+		return
+	}
+
+	write(writer, http.StatusOK, map[string]any{"id": seriesID, "cast": credits.Cast, "crew": credits.Crew})
+}
+
+func (s *Server) season(writer http.ResponseWriter, req *http.Request) {
+	season, ok := s.seasonOf(req)
+	if !ok {
+		failure(writer, http.StatusNotFound)
+
+		return
+	}
+
+	write(writer, http.StatusOK, season)
+}
+
+func (s *Server) episode(writer http.ResponseWriter, req *http.Request) {
+	season, ok := s.seasonOf(req)
+	number, err := strconv.Atoi(req.PathValue("episode"))
+
+	if ok && err == nil {
+		for _, episode := range season.Episodes {
+			if episode.EpisodeNumber == number {
+				write(writer, http.StatusOK, episode)
+
+				return
+			}
+		}
+	}
+
+	failure(writer, http.StatusNotFound)
+}
+
+// seasonOf finds the seeded season in the path.
+func (s *Server) seasonOf(req *http.Request) (tmdb.Season, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	seriesID, errID := strconv.Atoi(req.PathValue("id"))
+	number, errSeason := strconv.Atoi(req.PathValue("season"))
+
+	if errID != nil || errSeason != nil {
+		return *new(tmdb.Season), false
+	}
+
+	for _, season := range s.seasons[seriesID] {
+		if season.SeasonNumber == number {
+			return season, true
+		}
+	}
+
+	return *new(tmdb.Season), false
+}
@@ -0,0 +1,94 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/therenotomorrow/tmdb/pkg/tmdb"
+
+	attrOperation  = attribute.Key("tmdb.operation")
+	attrPage       = attribute.Key("tmdb.page")
+	attrAttempts   = attribute.Key("tmdb.attempts")
+	attrStatusCode = attribute.Key("tmdb.status_code")
+	attrMethod     = attribute.Key("http.request.method")
+	attrStatus     = attribute.Key("http.response.status_code")
+	attrPath       = attribute.Key("url.path")
+)
+
+type tracedTransport struct {
+	next   http.RoundTripper
+	tracer trace.Tracer
+}
+
+func tracing(tracer trace.Tracer) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, call *Call) error {
+			ctx, span := tracer.Start(ctx, "tmdb."+call.Operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attrOperation.String(call.Operation),
+					attrMethod.String(call.Method),
+					attrPath.String(call.Path),
+				),
+			)
+			defer span.End()
+
+			if page, err := strconv.Atoi(call.Query["page"]); err == nil {
+				span.SetAttributes(attrPage.Int(page))
+			}
+
+			err := next.Do(ctx, call)
+
+			span.SetAttributes(attrStatus.Int(call.Status), attrAttempts.Int(call.Attempt))
+
+			if call.Code != 0 {
+				span.SetAttributes(attrStatusCode.Int(call.Code))
+			}
+
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return err
+		})
+	}
+}
+
+// RoundTrip wraps every HTTP attempt in a child span of the logical call and propagates it as W3C trace context.
+func (t *tracedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := t.tracer.Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrMethod.String(req.Method), attrPath.String(req.URL.Path)),
+	)
+	defer span.End()
+
+	req = req.Clone(ctx)
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		err = redactError(err)
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	span.SetAttributes(attrStatus.Int(resp.StatusCode))
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,139 @@
+package tmdb_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb/mocks"
+)
+
+func tracedClient(recorder *tracetest.SpanRecorder) *tmdb.TMDB {
+	return fp.Must(tmdb.New(tmdb.Config{
+		Logger:         nil,
+		Tracing:        sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		Metrics:        nil,
+		Breaker:        nil,
+		Timeout:        time.Minute,
+		Host:           "https://tmdb.host",
+		Token:          "secret",
+		Auth:           "",
+		SessionID:      "",
+		GuestSessionID: "",
+		AccessToken:    "",
+		Language:       "",
+		Region:         "",
+	}))
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+
+	for _, attr := range span.Attributes() {
+		attrs[attr.Key] = attr.Value
+	}
+
+	return attrs
+}
+
+func TestTMDBTracingSuccess(t *testing.T) {
+	t.Parallel()
+
+	var traceparent string
+
+	recorder := tracetest.NewSpanRecorder()
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.MatchedBy(func(req *http.Request) bool {
+		traceparent = req.Header.Get("Traceparent")
+
+		return true
+	})).Return(successResponse(t), nil)
+
+	obj := tracedClient(recorder).SetTransport(trans)
+	_, err := obj.GetPopularMovies(t.Context(), 3)
+
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+
+	require.Len(t, spans, 2)
+
+	attempt, call := spans[0], spans[1]
+
+	assert.Equal(t, "tmdb.GetPopularMovies", call.Name())
+	assert.Equal(t, trace.SpanKindClient, call.SpanKind())
+	assert.Equal(t, codes.Unset, call.Status().Code)
+	assert.Equal(t, map[attribute.Key]attribute.Value{
+		"tmdb.operation":            attribute.StringValue("GetPopularMovies"),
+		"tmdb.page":                 attribute.IntValue(3),
+		"tmdb.attempts":             attribute.IntValue(1),
+		"http.request.method":       attribute.StringValue(http.MethodGet),
+		"http.response.status_code": attribute.IntValue(http.StatusOK),
+		"url.path":                  attribute.StringValue("/3/movie/popular"),
+	}, attributes(call))
+
+	assert.Equal(t, "HTTP GET", attempt.Name())
+	assert.Equal(t, call.SpanContext().SpanID(), attempt.Parent().SpanID())
+	assert.Equal(t, call.SpanContext().TraceID(), attempt.SpanContext().TraceID())
+	assert.Equal(t, attribute.IntValue(http.StatusOK), attributes(attempt)["http.response.status_code"])
+	assert.Equal(t, "00-"+attempt.SpanContext().TraceID().String()+"-"+attempt.SpanContext().SpanID().String()+"-01",
+		traceparent)
+}
+
+func TestTMDBTracingFailure(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.Anything).Return(failureResponse(t), nil)
+
+	obj := tracedClient(recorder).SetTransport(trans)
+	_, err := obj.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{Append: nil})
+
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+
+	require.Len(t, spans, 2)
+
+	attempt, call := spans[0], spans[1]
+
+	assert.Equal(t, "tmdb.GetMovieDetails", call.Name())
+	assert.Equal(t, codes.Error, call.Status().Code)
+	assert.Equal(t, attribute.IntValue(http.StatusTeapot), attributes(call)["http.response.status_code"])
+	assert.Equal(t, attribute.IntValue(42), attributes(call)["tmdb.status_code"])
+	assert.NotContains(t, attributes(call), attribute.Key("tmdb.page"))
+	assert.Equal(t, codes.Error, attempt.Status().Code)
+}
+
+func TestTMDBTracingTransportError(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	trans := mocks.NewMockRoundTripper(t)
+	trans.On("RoundTrip", mock.Anything).Return(nil, errFail)
+
+	obj := tracedClient(recorder).SetTransport(trans)
+	_, err := obj.GetPopularMovies(t.Context(), 1)
+
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "fail", spans[0].Status().Description)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
@@ -0,0 +1,48 @@
+package tmdb
+
+import (
+	"context"
+	"strconv"
+)
+
+const (
+	pathAltTitles    = "/alternative_titles"
+	pathTranslations = "/translations"
+)
+
+// Tag is the `language-REGION` code of the translation, such as `pt-BR`.
+func (t Translation) Tag() string {
+	if t.Country == "" {
+		return t.Language
+	}
+
+	return t.Language + "-" + t.Country
+}
+
+// Matches reports whether the translation is for tag: a plain language matches each of its regions.
+func (t Translation) Matches(tag string) bool {
+	return tag == t.Language || tag == t.Tag()
+}
+
+// GetAlternativeTitles lists the titles the movie is known by, in country only when it is set.
+func (c *TMDB) GetAlternativeTitles(ctx context.Context, movieID int, country string) ([]AltTitle, error) {
+	var data AltTitles
+
+	params := make(map[string]string)
+
+	if country != "" {
+		params["country"] = country
+	}
+
+	path := pathMovie + strconv.Itoa(movieID) + pathAltTitles
+
+	return data.Titles, c.get(ctx, "GetAlternativeTitles", path, params, &data)
+}
+
+func (c *TMDB) GetTranslations(ctx context.Context, movieID int) ([]Translation, error) {
+	var data Translations
+
+	path := pathMovie + strconv.Itoa(movieID) + pathTranslations
+
+	return data.Translations, c.get(ctx, "GetTranslations", path, make(map[string]string), &data)
+}
@@ -0,0 +1,67 @@
+package tmdb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func TestTMDBGetAlternativeTitles(t *testing.T) {
+	t.Parallel()
+
+	obj := fp.Must(tmdb.New(fixture(t).Config()))
+
+	titles, err := obj.GetAlternativeTitles(t.Context(), 550, "")
+
+	require.NoError(t, err)
+	assert.Len(t, titles, 3)
+
+	titles, err = obj.GetAlternativeTitles(t.Context(), 550, "MX")
+
+	require.NoError(t, err)
+	assert.Equal(t, []tmdb.AltTitle{{Country: "MX", Title: "El club de la pelea", Type: ""}}, titles)
+
+	_, err = obj.GetAlternativeTitles(t.Context(), 404, "")
+	assertPublic(t, err, "The resource you requested could not be found.")
+}
+
+func TestTMDBGetTranslations(t *testing.T) {
+	t.Parallel()
+
+	obj := fp.Must(tmdb.New(fixture(t).Config()))
+
+	got, err := obj.GetTranslations(t.Context(), 550)
+
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, "de-DE", got[0].Tag())
+	assert.Equal(t, "El club de la lucha", got[1].Data.Title)
+
+	details, err := obj.GetMovieDetails(t.Context(), 550, tmdb.DetailsOptions{
+		Append: []tmdb.Append{tmdb.AppendAltTitles, tmdb.AppendTranslations},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, details.AltTitles)
+	require.NotNil(t, details.Translations)
+	assert.Equal(t, got, details.Translations.Translations)
+
+	_, err = obj.GetTranslations(t.Context(), 404)
+	assertPublic(t, err, "The resource you requested could not be found.")
+}
+
+func TestTranslationMatches(t *testing.T) {
+	t.Parallel()
+
+	mexican := translation("es", "MX", "", "")
+
+	assert.True(t, mexican.Matches("es"))
+	assert.True(t, mexican.Matches("es-MX"))
+	assert.False(t, mexican.Matches("es-ES"))
+	assert.False(t, mexican.Matches("MX"))
+	assert.Equal(t, "eo", translation("eo", "", "", "").Tag())
+}
@@ -0,0 +1,44 @@
+package tmdb
+
+import (
+	"context"
+	"strconv"
+)
+
+const (
+	pathSeason           = "/season/"
+	pathEpisode          = "/episode/"
+	pathAggregateCredits = "/aggregate_credits"
+)
+
+func (c *TMDB) GetTVShowDetails(ctx context.Context, seriesID int) (TVShowDetails, error) {
+	var data TVShowDetails
+
+	return data, c.get(ctx, "GetTVShowDetails", pathTV+strconv.Itoa(seriesID), make(map[string]string), &data)
+}
+
+// GetTVSeason returns a season with its episodes, season 0 being the specials.
+func (c *TMDB) GetTVSeason(ctx context.Context, seriesID, season int) (Season, error) {
+	var data Season
+
+	path := pathTV + strconv.Itoa(seriesID) + pathSeason + strconv.Itoa(season)
+
+	return data, c.get(ctx, "GetTVSeason", path, make(map[string]string), &data)
+}
+
+func (c *TMDB) GetTVEpisode(ctx context.Context, seriesID, season, episode int) (Episode, error) {
+	var data Episode
+
+	path := pathTV + strconv.Itoa(seriesID) + pathSeason + strconv.Itoa(season) + pathEpisode + strconv.Itoa(episode)
+
+	return data, c.get(ctx, "GetTVEpisode", path, make(map[string]string), &data)
+}
+
+// GetAggregateCredits returns the cast and crew of all the seasons, with the episode count of each role and job.
+func (c *TMDB) GetAggregateCredits(ctx context.Context, seriesID int) (AggregateCredits, error) {
+	var data AggregateCredits
+
+	path := pathTV + strconv.Itoa(seriesID) + pathAggregateCredits
+
+	return data, c.get(ctx, "GetAggregateCredits", path, make(map[string]string), &data)
+}
@@ -0,0 +1,62 @@
+package tmdb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/therenotomorrow/tmdb/pkg/fp"
+	"github.com/therenotomorrow/tmdb/pkg/tmdb"
+)
+
+func TestTMDBGetTVShowDetails(t *testing.T) {
+	t.Parallel()
+
+	obj := fp.Must(tmdb.New(fixture(t).Config()))
+
+	got, err := obj.GetTVShowDetails(t.Context(), 1396)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Breaking Bad", got.Name)
+	require.Len(t, got.Seasons, 1)
+	assert.Equal(t, 2, got.Seasons[0].EpisodeCount)
+
+	credits, err := obj.GetAggregateCredits(t.Context(), 1396)
+
+	require.NoError(t, err)
+	require.Len(t, credits.Cast, 1)
+	assert.Equal(t, []tmdb.Role{{Character: "Walter White", EpisodeCount: 62}}, credits.Cast[0].Roles)
+
+	_, err = obj.GetTVShowDetails(t.Context(), 404)
+	assertPublic(t, err, "The resource you requested could not be found.")
+
+	_, err = obj.GetAggregateCredits(t.Context(), 404)
+	assertPublic(t, err, "The resource you requested could not be found.")
+}
+
+func TestTMDBGetTVSeason(t *testing.T) {
+	t.Parallel()
+
+	obj := fp.Must(tmdb.New(fixture(t).Config()))
+
+	season, err := obj.GetTVSeason(t.Context(), 1396, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Season 1", season.Name)
+	assert.Equal(t, []tmdb.Episode{
+		newEpisode(1, 1, "Pilot", "2008-01-20", 58),
+		newEpisode(1, 2, "Cat's in the Bag...", "2008-01-27", 48),
+	}, season.Episodes)
+
+	episode, err := obj.GetTVEpisode(t.Context(), 1396, 1, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, newEpisode(1, 2, "Cat's in the Bag...", "2008-01-27", 48), episode)
+
+	_, err = obj.GetTVSeason(t.Context(), 1396, 0)
+	assertPublic(t, err, "The resource you requested could not be found.")
+
+	_, err = obj.GetTVEpisode(t.Context(), 1396, 1, 3)
+	assertPublic(t, err, "The resource you requested could not be found.")
+}